@@ -1,33 +1,208 @@
 package app
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
 	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/gateway/blob"
 	"github.com/chatbot-go/app/gateway/client/twilio"
+	"github.com/chatbot-go/app/gateway/client/whatsappcloud"
+	"github.com/chatbot-go/app/gateway/flow"
 	"github.com/chatbot-go/app/gateway/postgres"
 	"github.com/chatbot-go/app/gateway/redis"
 	"github.com/chatbot-go/app/gateway/sqs"
 )
 
 type App struct {
-	UseCase      *usecase.UseCase
-	TwilioClient *twilio.Client
+	UseCase             *usecase.UseCase
+	TwilioTenants       *twilio.Tenants
+	WhatsappCloudClient *whatsappcloud.Client
 }
 
-func New(config config.Config, db *postgres.Client, redisClient *redis.Client, sqsEnqueuer *sqs.Enqueuer) (*App, error) { //nolint: revive
-	twilioClient := twilio.NewClient(config.Twilio)
+func New(ctx context.Context, config config.Config, db *postgres.Client, redisClient *redis.Client, sqsEnqueuer *sqs.Enqueuer, blobStore blob.Store) (*App, error) { //nolint: revive
+	const operation = "App.New"
+
+	tenantsRepository := postgres.NewTenantsRepository(db)
+
+	twilioTenants := twilio.NewTenants(twilio.NewClient(config.Twilio), tenantsRepository)
+	whatsappCloudClient := whatsappcloud.NewClient(config.WhatsappCloud)
+	whatsappCloudTenants := whatsappcloud.NewTenants(whatsappCloudClient, config.WhatsappCloud, tenantsRepository)
+
+	messengers, err := newMessengers(config.Messaging.Adapters, twilioTenants, whatsappCloudTenants)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	templatesRepository := postgres.NewTemplatesRepository(db)
+
+	templates, err := tenantTemplates(ctx, tenantsRepository, templatesRepository)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	flows, err := flow.Load(config.Conversation.FlowsDir, templates)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if _, ok := flows[config.Conversation.DefaultFlowID]; !ok {
+		return nil, fmt.Errorf("%s (%s) -> %w", operation, config.Conversation.DefaultFlowID, erring.ErrFlowNotFound)
+	}
+
+	quietHoursStart, quietHoursEnd, err := config.QuietHours.Window()
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	defaultLocation, err := time.LoadLocation(config.QuietHours.DefaultTimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	numberRates, err := parseRates(config.RateLimit.Numbers)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	channelRates, err := parseRates(config.RateLimit.Channels)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
 
 	useCase := &usecase.UseCase{
-		AppName:                config.App.Name,
-		Enqueuer:               sqsEnqueuer,
-		TwilioClient:           twilioClient,
-		JobsControlRepository:  postgres.NewJobsControlRepository(db),
-		UsersRepository:        postgres.NewUsersRepository(db),
-		UserMessagesRepository: postgres.NewUserMessagesRepository(db),
+		AppName:             config.App.Name,
+		Flows:               flows,
+		DefaultFlowID:       config.Conversation.DefaultFlowID,
+		ConversationTimeout: config.Conversation.SessionTimeout,
+		Onboarding: usecase.Onboarding{
+			AutoRegister:    config.Onboarding.AutoRegister,
+			WelcomeTemplate: config.Onboarding.WelcomeTemplate,
+		},
+		Consent: usecase.Consent{
+			OptOutKeywords: config.Consent.OptOutKeywords,
+			OptInKeywords:  config.Consent.OptInKeywords,
+			OptOutReply:    config.Consent.OptOutReply,
+			OptInReply:     config.Consent.OptInReply,
+		},
+		QuietHours: usecase.QuietHours{
+			Start:           quietHoursStart,
+			End:             quietHoursEnd,
+			DefaultLocation: defaultLocation,
+		},
+		DefaultLocale:         config.Templates.DefaultLocale,
+		Enqueuer:              sqsEnqueuer,
+		OutboundMaxAttempts:   config.SQS.OutboundMaxAttempts,
+		OutboundClaimTimeout:  config.SQS.OutboundClaimTimeout,
+		OutboundQueuedTimeout: config.SQS.OutboundQueuedTimeout,
+		RateLimiter:           redisClient,
+		RateLimits: usecase.RateLimits{
+			OriginNumber:    config.Twilio.OriginNumber,
+			TwilioProviders: twilioProviders(config.Messaging.Adapters),
+			Numbers:         numberRates,
+			Channels:        channelRates,
+			MaxWait:         config.RateLimit.MaxWait,
+		},
+		SMSMaxSegments:          config.SMS.MaxSegments,
+		Cache:                   redisClient,
+		BlobStore:               blobStore,
+		Messengers:              messengers,
+		TenantsRepository:       tenantsRepository,
+		JobsControlRepository:   postgres.NewJobsControlRepository(db),
+		UsersRepository:         postgres.NewUsersRepository(db),
+		UserMessagesRepository:  postgres.NewUserMessagesRepository(db),
+		ConversationsRepository: postgres.NewConversationsRepository(db),
+
+		UserMessageAttachmentsRepository: postgres.NewUserMessageAttachmentsRepository(db),
+		ScheduledMessagesRepository:      postgres.NewScheduledMessagesRepository(db),
+		TemplatesRepository:              templatesRepository,
+		CampaignsRepository:              postgres.NewCampaignsRepository(db),
+		CampaignDeliveriesRepository:     postgres.NewCampaignDeliveriesRepository(db),
+		HandoffsRepository:               postgres.NewHandoffsRepository(db),
+		ExportRunsRepository:             postgres.NewExportRunsRepository(db),
 	}
 
 	return &App{
-		UseCase:      useCase,
-		TwilioClient: twilioClient,
+		UseCase:             useCase,
+		TwilioTenants:       twilioTenants,
+		WhatsappCloudClient: whatsappCloudClient,
 	}, nil
 }
+
+func newMessengers(adapters map[string]string, twilioTenants *twilio.Tenants, whatsappCloudTenants *whatsappcloud.Tenants) (map[dto.Provider]usecase.Messenger, error) {
+	messengers := make(map[dto.Provider]usecase.Messenger, len(adapters))
+
+	for channel, adapter := range adapters {
+		switch adapter {
+		case config.TwilioAdapter:
+			messengers[dto.Provider(channel)] = twilioTenants
+		case config.WhatsappCloudAdapter:
+			messengers[dto.Provider(channel)] = whatsappCloudTenants
+		default:
+			return nil, fmt.Errorf("%s (%s) -> %w", channel, adapter, config.ErrMessagingAdapterInvalid)
+		}
+	}
+
+	return messengers, nil
+}
+
+// twilioProviders returns the channels sent through Twilio.
+func twilioProviders(adapters map[string]string) map[dto.Provider]bool {
+	providers := make(map[dto.Provider]bool, len(adapters))
+
+	for channel, adapter := range adapters {
+		if adapter == config.TwilioAdapter {
+			providers[dto.Provider(channel)] = true
+		}
+	}
+
+	return providers
+}
+
+func parseRates(rates map[string]string) (map[string]usecase.Rate, error) {
+	parsed := make(map[string]usecase.Rate, len(rates))
+
+	for key, rate := range rates {
+		count, period, err := config.ParseRate(rate)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		parsed[key] = usecase.Rate{Count: count, Period: period}
+	}
+
+	return parsed, nil
+}
+
+// tenantTemplates groups the template catalog by tenant. Tenants without
+// templates get an empty catalog, so flows using templates fail for them too.
+func tenantTemplates(ctx context.Context, tenantsRepository *postgres.TenantsRepository, templatesRepository *postgres.TemplatesRepository) (map[string][]entity.Template, error) {
+	const operation = "App.tenantTemplates"
+
+	tenants, err := tenantsRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	templates, err := templatesRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	grouped := make(map[string][]entity.Template, len(tenants))
+
+	for _, tenant := range tenants {
+		grouped[tenant.ID] = nil
+	}
+
+	for _, template := range templates {
+		grouped[template.TenantID] = append(grouped[template.TenantID], template)
+	}
+
+	return grouped, nil
+}
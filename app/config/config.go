@@ -1,12 +1,20 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
 
+var (
+	ErrRateInvalid             = errors.New("rate must be a positive count/period")
+	ErrMessagingAdapterInvalid = errors.New("messaging adapter must be twilio or whatsapp_cloud")
+)
+
 type Environment string
 
 const (
@@ -21,8 +29,14 @@ type Config struct {
 	Environment Environment `required:"true" envconfig:"ENVIRONMENT"`
 	Development bool        `required:"true" envconfig:"DEVELOPMENT"`
 
-	App    App
-	Server Server
+	App          App
+	Server       Server
+	Admin        Admin
+	Conversation Conversation
+	Onboarding   Onboarding
+	Consent      Consent
+	QuietHours   QuietHours
+	Templates    Templates
 
 	// Resilience
 	CircuitBreaker CircuitBreaker
@@ -32,12 +46,17 @@ type Config struct {
 	Otel     Otel
 	Postgres Postgres
 	Redis    Redis
+	Blob     Blob
 
 	// Messaging
-	SQS SQS
+	SQS       SQS
+	RateLimit RateLimit
+	SMS       SMS
+	Messaging Messaging
 
 	// External Services
-	Twilio Twilio
+	Twilio        Twilio
+	WhatsappCloud WhatsappCloud
 }
 
 type App struct {
@@ -53,6 +72,79 @@ type Server struct {
 	WriteTimeout time.Duration `required:"true" envconfig:"SERVER_WRITE_TIMEOUT"`
 }
 
+type Admin struct {
+	// Bearer token required by the admin endpoints, for every tenant. They are
+	// disabled when empty and no tenant token is set.
+	APIToken string `envconfig:"ADMIN_API_TOKEN"`
+
+	// Bearer tokens of the agents, as agent:token pairs, required by the agent
+	// endpoints. The agent id is recorded in the handoff audit trail.
+	AgentTokens map[string]string `envconfig:"ADMIN_AGENT_TOKENS"`
+
+	// Bearer tokens of the tenant admins, as tenant:token pairs. They reach the
+	// admin endpoints of their own tenant only.
+	TenantAPITokens map[string]string `envconfig:"ADMIN_TENANT_API_TOKENS"`
+
+	// Tenants of the agents, as agent:tenant pairs. Agents reach their own
+	// tenant only, the default one when missing.
+	AgentTenants map[string]string `envconfig:"ADMIN_AGENT_TENANTS"`
+}
+
+type Conversation struct {
+	// Idle time after which the user starts the flow over.
+	SessionTimeout time.Duration `envconfig:"CONVERSATION_SESSION_TIMEOUT" default:"24h"`
+
+	// Directory with the flow definitions. The embedded flows are used when empty.
+	FlowsDir      string `envconfig:"CONVERSATION_FLOWS_DIR"`
+	DefaultFlowID string `envconfig:"CONVERSATION_DEFAULT_FLOW_ID" default:"default"`
+}
+
+type Onboarding struct {
+	// Creates users for unknown senders instead of rejecting their messages.
+	AutoRegister    bool   `envconfig:"ONBOARDING_AUTO_REGISTER" default:"true"`
+	WelcomeTemplate string `envconfig:"ONBOARDING_WELCOME_TEMPLATE"`
+}
+
+type Consent struct {
+	OptOutKeywords []string `envconfig:"CONSENT_OPT_OUT_KEYWORDS" default:"STOP,STOPALL,UNSUBSCRIBE,CANCEL,END,QUIT,PARAR,SAIR,CANCELAR,DESCADASTRAR"`
+	OptInKeywords  []string `envconfig:"CONSENT_OPT_IN_KEYWORDS"  default:"START,UNSTOP,VOLTAR,INICIAR"`
+	OptOutReply    string   `envconfig:"CONSENT_OPT_OUT_REPLY"    default:"Você não receberá mais mensagens. Envie VOLTAR para receber novamente."`
+	OptInReply     string   `envconfig:"CONSENT_OPT_IN_REPLY"     default:"Pronto! Você voltará a receber nossas mensagens."`
+}
+
+type QuietHours struct {
+	// Local time window, as HH:MM, in which broadcasts are deferred. Disabled when start equals end.
+	Start           string `envconfig:"QUIET_HOURS_START"             default:"21:00"`
+	End             string `envconfig:"QUIET_HOURS_END"               default:"08:00"`
+	DefaultTimeZone string `envconfig:"QUIET_HOURS_DEFAULT_TIME_ZONE" default:"America/Sao_Paulo"`
+}
+
+type Templates struct {
+	// Locale used for users without a preferred one.
+	DefaultLocale string `envconfig:"TEMPLATES_DEFAULT_LOCALE" default:"pt_BR"`
+}
+
+// Window returns the start and end of the quiet hours as offsets from midnight.
+func (q QuietHours) Window() (time.Duration, time.Duration, error) {
+	const operation = "Config.QuietHours.Window"
+
+	start, err := time.Parse("15:04", q.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	end, err := time.Parse("15:04", q.End)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return sinceMidnight(start), sinceMidnight(end), nil
+}
+
+func sinceMidnight(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
+
 type CircuitBreaker struct {
 	Timeout time.Duration `required:"true" envconfig:"CIRCUIT_BREAKER_TIMEOUT"`
 
@@ -114,6 +206,18 @@ func (r Redis) Address() string {
 	return r.Host + ":" + r.Port
 }
 
+type Blob struct {
+	Driver   string `envconfig:"BLOB_DRIVER"    default:"local"` // local or s3
+	LocalDir string `envconfig:"BLOB_LOCAL_DIR" default:"./data/blob"`
+
+	S3Bucket          string `envconfig:"BLOB_S3_BUCKET"`
+	S3Region          string `envconfig:"BLOB_S3_REGION"            default:"us-east-1"`
+	S3Endpoint        string `envconfig:"BLOB_S3_ENDPOINT"` // S3-compatible services only
+	S3UsePathStyle    bool   `envconfig:"BLOB_S3_USE_PATH_STYLE"`
+	S3AccessKeyID     string `envconfig:"BLOB_S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `envconfig:"BLOB_S3_SECRET_ACCESS_KEY"`
+}
+
 type SQS struct {
 	SessionMaxRetries int           `required:"true" envconfig:"SQS_SESSION_MAX_RETRIES"`
 	MaxWorkers        int           `required:"true" envconfig:"SQS_MAX_WORKERS"`
@@ -122,14 +226,106 @@ type SQS struct {
 	VisibilityTimeout time.Duration `required:"true" envconfig:"SQS_VISIBILITY_TIMEOUT"`
 
 	// Queues
-	WebhooksTwilioQueue string `required:"true" envconfig:"SQS_WEBHOOKS_TWILIO_QUEUE"`
+	WebhooksTwilioQueue       string `required:"true" envconfig:"SQS_WEBHOOKS_TWILIO_QUEUE"`
+	WebhooksTwilioStatusQueue string `required:"true" envconfig:"SQS_WEBHOOKS_TWILIO_STATUS_QUEUE"`
+	OutboundQueue             string `required:"true" envconfig:"SQS_OUTBOUND_QUEUE"`
+
+	// Deliveries of an outbound message before it is given up as failed.
+	OutboundMaxAttempts int `envconfig:"SQS_OUTBOUND_MAX_ATTEMPTS" default:"5"`
+
+	// Age of a campaign delivery claim after which the worker holding it is
+	// taken as stopped and the delivery is sent again. Longer than the
+	// deduplication window of the queue, five minutes, so the message is not
+	// dropped when queued again.
+	OutboundClaimTimeout time.Duration `envconfig:"SQS_OUTBOUND_CLAIM_TIMEOUT" default:"15m"`
+
+	// Age of a campaign delivery queued and never claimed after which its
+	// message is taken as lost and queued again. Longer than the claim timeout,
+	// as a backed up queue or rate limited sends leave deliveries waiting.
+	OutboundQueuedTimeout time.Duration `envconfig:"SQS_OUTBOUND_QUEUED_TIMEOUT" default:"6h"`
+}
+
+// RateLimit holds the outbound limits as count/period, e.g. 80/1s or 1000/24h,
+// keyed by origin number or channel. Channel limits apply to each tenant on
+// its own.
+type RateLimit struct {
+	Numbers  map[string]string `envconfig:"RATE_LIMIT_NUMBERS"`
+	Channels map[string]string `envconfig:"RATE_LIMIT_CHANNELS" default:"whatsapp:80/1s"`
+
+	// Longest a send blocks waiting for the limit before being rescheduled.
+	MaxWait time.Duration `envconfig:"RATE_LIMIT_MAX_WAIT" default:"5s"`
+}
+
+// ParseRate parses a count/period limit.
+func ParseRate(rate string) (int, time.Duration, error) {
+	const operation = "Config.ParseRate"
+
+	countValue, periodValue, found := strings.Cut(rate, "/")
+	if !found {
+		return 0, 0, fmt.Errorf("%s (%s) -> %w", operation, rate, ErrRateInvalid)
+	}
+
+	count, err := strconv.Atoi(countValue)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s (%s) -> %w", operation, rate, err)
+	}
+
+	period, err := time.ParseDuration(periodValue)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s (%s) -> %w", operation, rate, err)
+	}
+
+	if count <= 0 || period <= 0 {
+		return 0, 0, fmt.Errorf("%s (%s) -> %w", operation, rate, ErrRateInvalid)
+	}
+
+	return count, period, nil
+}
+
+type SMS struct {
+	// Messages longer than this are rejected instead of being split in more
+	// billed segments. Unlimited when zero.
+	MaxSegments int `envconfig:"SMS_MAX_SEGMENTS" default:"10"`
+}
+
+// Messaging picks the adapter that serves each channel, as channel:adapter
+// pairs, e.g. whatsapp:whatsapp_cloud,sms:twilio.
+type Messaging struct {
+	Adapters map[string]string `envconfig:"MESSAGING_ADAPTERS" default:"whatsapp:twilio,sms:twilio"`
 }
 
+const (
+	TwilioAdapter        = "twilio"
+	WhatsappCloudAdapter = "whatsapp_cloud"
+)
+
 type Twilio struct {
 	AccountSID          string `required:"true" envconfig:"TWILIO_ACCOUNT_SID"`
 	AuthToken           string `required:"true" envconfig:"TWILIO_AUTH_TOKEN"`
 	OriginNumber        string `required:"true" envconfig:"TWILIO_ORIGIN_NUMBER"`
 	MessagingServiceSid string `required:"true" envconfig:"TWILIO_MESSAGING_SERVICE_SID"`
+
+	// Public URL of the status webhook. Delivery statuses are not tracked when empty.
+	StatusCallbackURL string `envconfig:"TWILIO_STATUS_CALLBACK_URL"`
+
+	// How long handled webhooks are remembered to drop the ones Twilio retries.
+	WebhookIdempotencyTTL time.Duration `envconfig:"TWILIO_WEBHOOK_IDEMPOTENCY_TTL" default:"24h"`
+}
+
+// WhatsappCloud holds the Meta app and the number of the tenants without their
+// own Cloud number.
+type WhatsappCloud struct {
+	BaseURL       string        `envconfig:"WHATSAPP_CLOUD_BASE_URL"        default:"https://graph.facebook.com/v21.0"`
+	PhoneNumberID string        `envconfig:"WHATSAPP_CLOUD_PHONE_NUMBER_ID"`
+	AccessToken   string        `envconfig:"WHATSAPP_CLOUD_ACCESS_TOKEN"`
+	Timeout       time.Duration `envconfig:"WHATSAPP_CLOUD_TIMEOUT"         default:"30s"`
+
+	// Secret of the Meta app, used to sign the webhooks. Every webhook is
+	// rejected when empty.
+	AppSecret string `envconfig:"WHATSAPP_CLOUD_APP_SECRET"`
+
+	// Token echoed by Meta when subscribing the webhook.
+	VerifyToken string `envconfig:"WHATSAPP_CLOUD_VERIFY_TOKEN"`
 }
 
 func New() (Config, error) {
@@ -0,0 +1,118 @@
+package dto
+
+// InboundMessage is a message received on any channel, normalised by the
+// adapter of its provider before being queued.
+type InboundMessage struct {
+	TenantID         string            `json:"tenant_id,omitempty"`
+	MessageSid       string            `json:"message_sid"`
+	MessageBody      string            `json:"message_body"`
+	PhoneNumber      string            `json:"phone_number"`
+	ProfileName      string            `json:"profile_name"`
+	WaID             string            `json:"wa_id"`
+	Provider         Provider          `json:"provider,omitempty"`
+	InteractiveReply *InteractiveReply `json:"interactive_reply,omitempty"`
+	Media            []Media           `json:"media,omitempty"`
+
+	// Business number the message was sent to, which routes it to its tenant.
+	To string `json:"to,omitempty"`
+}
+
+type Media struct {
+	// URL, or id for providers that serve media by id, passed back to the
+	// adapter to download it.
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+}
+
+// MessageStatusUpdate is a delivery status reported by a provider for a
+// message it sent.
+type MessageStatusUpdate struct {
+	TenantID   string `json:"tenant_id,omitempty"`
+	MessageSid string `json:"message_sid"`
+	Status     string `json:"status"`
+	ErrorCode  string `json:"error_code"`
+
+	// Business number the message was sent from, which routes the status to
+	// its tenant.
+	From string `json:"from,omitempty"`
+}
+
+type InteractiveReplyType string
+
+const (
+	ButtonReply InteractiveReplyType = "button"
+	ListReply   InteractiveReplyType = "list"
+)
+
+// InteractiveReply is the option a user picked from a quick reply button or a
+// list message.
+type InteractiveReply struct {
+	Type  InteractiveReplyType `json:"type"`
+	ID    string               `json:"id"`
+	Title string               `json:"title"`
+}
+
+type SendMessageTemplateInput struct {
+	Provider
+	DestinationNumber string
+	TemplateName      string
+	Locale            string
+	Variables         map[string]string
+
+	// Resolved from the template catalog before sending.
+	ContentSID string
+
+	// Transactional messages are delivered even to users who opted out.
+	Transactional bool
+
+	// Campaign the message is sent for, recorded with it.
+	CampaignID string
+}
+
+type SendMessageInput struct {
+	Provider
+	DestinationNumber string
+	Message           string
+
+	// Transactional messages are delivered even to users who opted out.
+	Transactional bool
+
+	// Campaign the message is sent for, recorded with it.
+	CampaignID string
+}
+
+type SendMediaInput struct {
+	Provider
+	DestinationNumber string
+	MediaURL          string
+	Caption           string
+
+	// Transactional messages are delivered even to users who opted out.
+	Transactional bool
+}
+
+type Provider string
+
+const (
+	WhatsappProvider Provider = "whatsapp"
+	SMSProvider      Provider = "sms"
+)
+
+// OutboundMessage is a single send handed to the workers, either of a
+// campaign or, without a campaign, of a message such as the welcome of new
+// users. Free-form messages have a body instead of a template.
+type OutboundMessage struct {
+	TenantID     string            `json:"tenant_id,omitempty"`
+	CampaignID   string            `json:"campaign_id"`
+	UserID       string            `json:"user_id"`
+	TemplateName string            `json:"template_name"`
+	Locale       string            `json:"locale"`
+	Variables    map[string]string `json:"variables"`
+
+	Message       string `json:"message,omitempty"`
+	Transactional bool   `json:"transactional,omitempty"`
+
+	// Identifies the messages sent without a campaign, so the queue drops
+	// duplicates. Campaign messages are identified by campaign and user.
+	Key string `json:"key,omitempty"`
+}
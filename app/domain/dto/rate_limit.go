@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+// RateLimit is a count per period limit stored under a key.
+type RateLimit struct {
+	Key    string
+	Count  int
+	Period time.Duration
+}
@@ -0,0 +1,65 @@
+package dto
+
+import "time"
+
+// UsersFilter selects a page of users ordered by id.
+type UsersFilter struct {
+	// Matches names containing the text, case-insensitively.
+	Name string
+
+	// Matches phone numbers starting with the prefix.
+	PhoneNumber string
+
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+
+	// Id of the last user of the previous page.
+	AfterID string
+	Limit   int
+}
+
+// UserMessagesFilter selects a page of the messages of a user in
+// chronological order.
+type UserMessagesFilter struct {
+	UserID string
+
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+
+	// Position of the last message of the previous page, ignored when
+	// AfterID is empty.
+	AfterCreatedAt time.Time
+	AfterID        string
+	Limit          int
+}
+
+// UserMessagesSearch selects a page of the messages matching a full-text
+// query, newest first.
+type UserMessagesSearch struct {
+	// Web search syntax: quoted phrases, OR and -excluded words.
+	Query string
+
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+
+	// Position of the last message of the previous page, ignored when
+	// BeforeID is empty.
+	BeforeCreatedAt time.Time
+	BeforeID        string
+	Limit           int
+}
+
+// UsersImportResult counts the users written by an import batch.
+type UsersImportResult struct {
+	Inserted int
+	Updated  int
+}
+
+// UserMessagesExport selects the messages of a conversation export.
+type UserMessagesExport struct {
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+
+	// Exports every user when empty.
+	UserID string
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// Campaign is a template broadcast to the users matching an audience.
+type Campaign struct {
+	ID           string
+	TenantID     string
+	Name         string
+	TemplateName string
+	Locale       string
+	Audience     CampaignAudience
+
+	// Maps template variables to the user field that fills them.
+	Variables map[string]string
+
+	Status      types.CampaignStatus
+	ScheduledAt *time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+
+	Progress CampaignProgress
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CampaignAudience filters the users reached by a campaign. Empty filters
+// match every user; users who opted out are never reached.
+type CampaignAudience struct {
+	PhonePrefixes []string   `json:"phone_prefixes,omitempty"`
+	TimeZones     []string   `json:"time_zones,omitempty"`
+	CreatedAfter  *time.Time `json:"created_after,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+}
+
+type CampaignProgress struct {
+	// Last user handled, so an interrupted or paused run resumes after it.
+	CursorUserID string
+
+	// Written by the campaign run.
+	Target   int
+	Queued   int
+	Deferred int
+
+	// Written by the workers as queued messages are handled.
+	Sent    int
+	Skipped int
+	Failed  int
+}
+
+var campaignTransitions = map[types.CampaignStatus][]types.CampaignStatus{
+	types.CampaignDraft:     {types.CampaignScheduled, types.CampaignCanceled},
+	types.CampaignScheduled: {types.CampaignScheduled, types.CampaignRunning, types.CampaignPaused, types.CampaignCanceled},
+	types.CampaignRunning:   {types.CampaignPaused, types.CampaignCompleted, types.CampaignCanceled},
+	types.CampaignPaused:    {types.CampaignScheduled, types.CampaignCanceled},
+}
+
+// Transition moves the campaign to status, setting the matching timestamps.
+func (c Campaign) Transition(status types.CampaignStatus, now time.Time) (Campaign, error) {
+	if !slices.Contains(campaignTransitions[c.Status], status) {
+		return Campaign{}, fmt.Errorf("%w: %s -> %s", erring.ErrCampaignTransitionInvalid, c.Status, status)
+	}
+
+	c.Status = status
+
+	switch status {
+	case types.CampaignRunning:
+		if c.StartedAt == nil {
+			c.StartedAt = &now
+		}
+	case types.CampaignCompleted, types.CampaignCanceled:
+		c.CompletedAt = &now
+	}
+
+	return c, nil
+}
+
+// ResolveVariables fills the template variables from the user fields.
+func (c Campaign) ResolveVariables(user User) map[string]string {
+	variables := make(map[string]string, len(c.Variables))
+
+	for variable, field := range c.Variables {
+		variables[variable], _ = user.Field(field)
+	}
+
+	return variables
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// CampaignDelivery is the ledger entry of a campaign message to a single
+// user. There is at most one per campaign and user, which keeps reruns from
+// reaching anyone twice.
+type CampaignDelivery struct {
+	CampaignID string
+	UserID     string
+	Status     types.CampaignDeliveryStatus
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
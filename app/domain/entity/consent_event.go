@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// ConsentEvent is an audit record of a change in the user consent.
+type ConsentEvent struct {
+	ID      string
+	UserID  string
+	Consent types.Consent
+	Source  types.ConsentSource
+	Keyword string
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+)
+
+type Conversation struct {
+	UserID         string
+	FlowID         string
+	CurrentNode    string
+	Variables      map[string]string
+	LastActivityAt time.Time
+}
+
+// IsExpired reports whether the conversation has been idle for longer than timeout.
+func (c Conversation) IsExpired(now time.Time, timeout time.Duration) bool {
+	return now.Sub(c.LastActivityAt) > timeout
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// ExportRun records a conversation export, its filters and how it ended.
+type ExportRun struct {
+	ID          string
+	Format      types.ExportFormat
+	Destination string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UserID      string
+
+	Status       types.ExportRunStatus
+	RowsExported int
+	Error        string
+
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"strings"
+)
+
+// Flow is a dialog the bot walks a user through, one node per answer.
+type Flow struct {
+	ID        string
+	StartNode string
+	Nodes     map[string]FlowNode
+}
+
+type FlowNode struct {
+	ID string
+
+	// Reply sent when the user reaches the node. Either Message or the name
+	// of a catalog Template must be set; both support {{variable}} placeholders.
+	Message           string
+	Template          string
+	TemplateVariables map[string]string
+
+	// Media sent with the message as its caption.
+	MediaURL string
+
+	// Name of the conversation variable the user answer is stored in.
+	SaveAs string
+
+	// Reply sent when the answer matches none of the transitions.
+	Fallback string
+
+	// Hands the conversation to a human agent once the reply is sent.
+	Handoff bool
+
+	Transitions []FlowTransition
+}
+
+type FlowTransition struct {
+	// Accepted answers or interactive option ids, compared case-insensitively.
+	// Empty matches any answer.
+	Match []string
+	Next  string
+}
+
+func (f Flow) Node(id string) (FlowNode, bool) {
+	node, ok := f.Nodes[id]
+
+	return node, ok
+}
+
+// IsFinal reports whether the node ends the flow.
+func (n FlowNode) IsFinal() bool {
+	return len(n.Transitions) == 0
+}
+
+// Next returns the node the first matching answer leads to.
+func (n FlowNode) Next(answers ...string) (string, bool) {
+	for _, transition := range n.Transitions {
+		if len(transition.Match) == 0 {
+			return transition.Next, true
+		}
+
+		for _, answer := range answers {
+			answer = strings.TrimSpace(answer)
+			if answer == "" {
+				continue
+			}
+
+			for _, match := range transition.Match {
+				if strings.EqualFold(answer, match) {
+					return transition.Next, true
+				}
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// Handoff is a conversation handed to a human agent. The bot does not reply
+// to the user while it is not closed.
+type Handoff struct {
+	ID      string
+	User    User
+	Status  types.HandoffStatus
+	AgentID string
+	Reason  string
+
+	OpenedAt  time.Time
+	ClaimedAt *time.Time
+	ClosedAt  *time.Time
+}
+
+// HandoffEvent is an audit trail entry of what agents did with a handoff.
+type HandoffEvent struct {
+	HandoffID string
+	Action    types.HandoffAction
+	AgentID   string
+
+	CreatedAt time.Time
+}
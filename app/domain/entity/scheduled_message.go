@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// ScheduledMessage is a template send deferred to a later time.
+type ScheduledMessage struct {
+	ID               string
+	TenantID         string
+	UserID           string
+	TemplateName     string
+	Locale           string
+	ContentVariables map[string]string
+	Status           types.ScheduledMessageStatus
+	SendAt           time.Time
+	SentAt           *time.Time
+
+	// Campaign whose deferred delivery the message settles, empty otherwise.
+	CampaignID string
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Template is a pre-approved message registered in Twilio Content API.
+type Template struct {
+	ID         string
+	TenantID   string
+	Name       string
+	Locale     string
+	Channel    string
+	ContentSID string
+	Variables  []TemplateVariable
+
+	// Plain-text version with {{variable}} placeholders, sent on channels
+	// without content sids such as SMS.
+	Body string
+
+	CreatedAt time.Time
+}
+
+type TemplateVariable struct {
+	Name      string `json:"name"`
+	Required  bool   `json:"required"`
+	MaxLength int    `json:"max_length,omitempty"`
+}
+
+// ValidateVariables checks the variables against the declared schema. Keys
+// that are not declared are rejected, so a typo fails before reaching Twilio.
+func (t Template) ValidateVariables(variables map[string]string) error {
+	keys := make([]*validation.KeyRules, 0, len(t.Variables))
+
+	for _, variable := range t.Variables {
+		var rules []validation.Rule
+
+		if variable.Required {
+			rules = append(rules, validation.Required)
+		}
+
+		if variable.MaxLength > 0 {
+			rules = append(rules, validation.RuneLength(0, variable.MaxLength))
+		}
+
+		key := validation.Key(variable.Name, rules...)
+		if !variable.Required {
+			key = key.Optional()
+		}
+
+		keys = append(keys, key)
+	}
+
+	return validation.Validate(variables, validation.Map(keys...))
+}
+
+// ValidateVariableNames checks only the names of the variables, for mappings
+// whose values are known later: every name must be declared and every
+// required variable must be present. The values are validated when sent.
+func (t Template) ValidateVariableNames(names []string) error {
+	errs := validation.Errors{}
+
+	declared := make(map[string]bool, len(t.Variables))
+	for _, variable := range t.Variables {
+		declared[variable.Name] = true
+	}
+
+	present := make(map[string]bool, len(names))
+	for _, name := range names {
+		present[name] = true
+
+		if !declared[name] {
+			errs[name] = validation.ErrKeyUnexpected
+		}
+	}
+
+	for _, variable := range t.Variables {
+		if variable.Required && !present[variable.Name] {
+			errs[variable.Name] = validation.ErrKeyMissing
+		}
+	}
+
+	return errs.Filter() //nolint:wrapcheck
+}
+
+// Render fills the placeholders of the plain-text body with the variables.
+func (t Template) Render(variables map[string]string) string {
+	pairs := make([]string, 0, len(variables)*2) //nolint:gomnd
+	for name, value := range variables {
+		pairs = append(pairs, "{{"+name+"}}", value)
+	}
+
+	return strings.NewReplacer(pairs...).Replace(t.Body)
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+// DefaultTenantID is the tenant seeded for the data that predates tenants.
+// Work started without a tenant, such as jobs run without one, falls in it.
+const DefaultTenantID = "1"
+
+// Tenant is a business unit running its own bot, with its own Twilio and
+// WhatsApp Cloud accounts, numbers, templates and users.
+type Tenant struct {
+	ID   string
+	Name string
+
+	TwilioAccountSID          string
+	TwilioAuthToken           string
+	TwilioMessagingServiceSid string
+	TwilioStatusCallbackURL   string
+	OriginNumber              string
+
+	WhatsappCloudPhoneNumberID string
+	WhatsappCloudAccessToken   string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UsesDefaultAccount tells whether the tenant sends through the Twilio
+// account of the config instead of its own.
+func (t Tenant) UsesDefaultAccount() bool {
+	return t.TwilioAccountSID == ""
+}
+
+// UsesDefaultCloudAccount tells whether the tenant sends through the WhatsApp
+// Cloud account of the config instead of its own.
+func (t Tenant) UsesDefaultCloudAccount() bool {
+	return t.WhatsappCloudPhoneNumberID == ""
+}
+
+// TenantIDFromContext returns the tenant the work of the context belongs to.
+func TenantIDFromContext(ctx context.Context) string {
+	if id, ok := ctxkey.GetTenantID(ctx); ok && id != "" {
+		return id
+	}
+
+	return DefaultTenantID
+}
@@ -1,13 +1,49 @@
 package entity
 
 import (
+	"strings"
 	"time"
+
+	"github.com/chatbot-go/app/domain/types"
 )
 
+const attributesPrefix = "attributes."
+
 type User struct {
 	ID          string
 	Name        string
 	PhoneNumber string
+	WaID        string
+	Consent     types.Consent
+	TimeZone    string
+
+	// Channel the user is reached on, the last one they wrote from.
+	PreferredChannel string
+
+	// Free-form values set by imports, usable in campaign variables as
+	// attributes.<name>.
+	Attributes map[string]string
 
 	CreatedAt time.Time
 }
+
+// Field returns the value of a user field by its name, as used by campaign
+// variable mappings. Attributes are always known, missing ones are empty.
+func (u User) Field(name string) (string, bool) {
+	if attribute, ok := strings.CutPrefix(name, attributesPrefix); ok {
+		return u.Attributes[attribute], attribute != ""
+	}
+
+	switch name {
+	case "name":
+		return u.Name, true
+	case "phone_number":
+		return u.PhoneNumber, true
+	case "wa_id":
+		return u.WaID, true
+	case "time_zone":
+		return u.TimeZone, true
+	default:
+		return "", false
+	}
+}
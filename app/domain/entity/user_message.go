@@ -2,12 +2,37 @@ package entity
 
 import (
 	"time"
+
+	"github.com/chatbot-go/app/domain/types"
 )
 
 type UserMessage struct {
-	ID      string
-	UserID  string
-	Message string
+	ID        string
+	UserID    string
+	Direction types.MessageDirection
+	Message   string
+
+	TwilioSID        string
+	TemplateName     string
+	ContentVariables map[string]string
+	Status           types.MessageStatus
+	ErrorCode        string
+
+	// Campaign the outbound message was sent for, empty otherwise.
+	CampaignID string
+
+	// When the bot finished handling the inbound message.
+	ProcessedAt *time.Time
 
 	CreatedAt time.Time
 }
+
+// UserMessageMatch is a message found by a full-text search.
+type UserMessageMatch struct {
+	Message UserMessage
+	User    User
+
+	// HTML excerpt of the message, escaped, with the matched words wrapped
+	// in <mark> tags.
+	Headline string
+}
@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"time"
+)
+
+type UserMessageAttachment struct {
+	ID            string
+	UserMessageID string
+	ContentType   string
+	SourceURL     string
+	StorageKey    string
+	Size          int64
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,7 @@
+package erring
+
+var (
+	ErrCampaignNotFound          = NewAppError("campaign:not-found", "campaign not found")
+	ErrCampaignTransitionInvalid = NewAppError("campaign:transition-invalid", "campaign cannot move to the requested status")
+	ErrCampaignVariablesInvalid  = NewAppError("campaign:variables-invalid", "campaign variables reference unknown user fields")
+)
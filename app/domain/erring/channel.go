@@ -0,0 +1,3 @@
+package erring
+
+var ErrChannelNotSupported = NewAppError("channel:not-supported", "no messaging adapter serves the channel")
@@ -0,0 +1,3 @@
+package erring
+
+var ErrUserOptedOut = NewAppError("consent:user-opted-out", "user opted out of receiving messages")
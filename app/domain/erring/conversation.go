@@ -0,0 +1,8 @@
+package erring
+
+var (
+	ErrConversationNotFound = NewAppError("conversation:not-found", "conversation not found")
+	ErrFlowNotFound         = NewAppError("flow:not-found", "flow not found")
+	ErrFlowNodeNotFound     = NewAppError("flow:node-not-found", "flow node not found")
+	ErrFlowInvalid          = NewAppError("flow:invalid", "invalid flow definition")
+)
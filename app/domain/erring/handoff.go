@@ -0,0 +1,7 @@
+package erring
+
+var (
+	ErrHandoffNotFound          = NewAppError("handoff:not-found", "handoff not found")
+	ErrHandoffTransitionInvalid = NewAppError("handoff:transition-invalid", "handoff cannot move to the requested status")
+	ErrHandoffNotClaimedByAgent = NewAppError("handoff:not-claimed-by-agent", "handoff is not claimed by the agent")
+)
@@ -0,0 +1,7 @@
+package erring
+
+var (
+	ErrTemplateNotFound         = NewAppError("template:not-found", "template not found")
+	ErrTemplateVariablesInvalid = NewAppError("template:variables-invalid", "template variables are invalid")
+	ErrTemplateBodyMissing      = NewAppError("template:body-missing", "template has no plain-text body for sms")
+)
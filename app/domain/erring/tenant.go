@@ -0,0 +1,3 @@
+package erring
+
+var ErrTenantNotFound = NewAppError("tenant:not-found", "tenant not found")
@@ -0,0 +1,6 @@
+package erring
+
+var (
+	ErrUserNotFound      = NewAppError("user:not-found", "user not found")
+	ErrUserAlreadyExists = NewAppError("user:already-exists", "a user with this phone number already exists")
+)
@@ -0,0 +1,7 @@
+package erring
+
+var (
+	ErrUserMessageAlreadyExists = NewAppError("user-message:already-exists", "user message already exists")
+	ErrUserMessageNotFound      = NewAppError("user-message:not-found", "user message not found")
+	ErrUserMessageTooLong       = NewAppError("user-message:too-long", "message exceeds the maximum number of sms segments")
+)
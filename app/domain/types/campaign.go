@@ -0,0 +1,23 @@
+package types
+
+type CampaignStatus string
+
+const (
+	CampaignDraft     CampaignStatus = "draft"
+	CampaignScheduled CampaignStatus = "scheduled"
+	CampaignRunning   CampaignStatus = "running"
+	CampaignPaused    CampaignStatus = "paused"
+	CampaignCompleted CampaignStatus = "completed"
+	CampaignCanceled  CampaignStatus = "canceled"
+)
+
+type CampaignDeliveryStatus string
+
+const (
+	CampaignDeliveryQueued   CampaignDeliveryStatus = "queued"
+	CampaignDeliveryDeferred CampaignDeliveryStatus = "deferred"
+	CampaignDeliverySending  CampaignDeliveryStatus = "sending"
+	CampaignDeliverySent     CampaignDeliveryStatus = "sent"
+	CampaignDeliverySkipped  CampaignDeliveryStatus = "skipped"
+	CampaignDeliveryFailed   CampaignDeliveryStatus = "failed"
+)
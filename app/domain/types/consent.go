@@ -0,0 +1,14 @@
+package types
+
+type Consent string
+
+const (
+	ConsentOptedIn  Consent = "opted_in"
+	ConsentOptedOut Consent = "opted_out"
+)
+
+type ConsentSource string
+
+const (
+	ConsentSourceKeyword ConsentSource = "keyword"
+)
@@ -0,0 +1,16 @@
+package types
+
+type ExportFormat string
+
+const (
+	ExportCSV   ExportFormat = "csv"
+	ExportJSONL ExportFormat = "jsonl"
+)
+
+type ExportRunStatus string
+
+const (
+	ExportRunRunning   ExportRunStatus = "running"
+	ExportRunCompleted ExportRunStatus = "completed"
+	ExportRunFailed    ExportRunStatus = "failed"
+)
@@ -0,0 +1,18 @@
+package types
+
+type HandoffStatus string
+
+const (
+	HandoffOpen    HandoffStatus = "open"
+	HandoffClaimed HandoffStatus = "claimed"
+	HandoffClosed  HandoffStatus = "closed"
+)
+
+type HandoffAction string
+
+const (
+	HandoffActionOpened  HandoffAction = "opened"
+	HandoffActionClaimed HandoffAction = "claimed"
+	HandoffActionReplied HandoffAction = "replied"
+	HandoffActionClosed  HandoffAction = "closed"
+)
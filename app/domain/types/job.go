@@ -3,5 +3,6 @@ package types
 type Job string
 
 const (
-	SendMessage Job = "send-message"
+	SendCampaigns         Job = "send-campaigns"
+	SendScheduledMessages Job = "send-scheduled-messages"
 )
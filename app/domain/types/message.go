@@ -0,0 +1,39 @@
+package types
+
+type MessageDirection string
+
+const (
+	InboundMessage  MessageDirection = "inbound"
+	OutboundMessage MessageDirection = "outbound"
+)
+
+type MessageStatus string
+
+const (
+	MessageReceived    MessageStatus = "received"
+	MessageQueued      MessageStatus = "queued"
+	MessageSent        MessageStatus = "sent"
+	MessageDelivered   MessageStatus = "delivered"
+	MessageRead        MessageStatus = "read"
+	MessageFailed      MessageStatus = "failed"
+	MessageUndelivered MessageStatus = "undelivered"
+)
+
+// Rank orders outbound statuses so late callbacks do not move a message
+// backwards. Statuses we do not track rank 0.
+func (s MessageStatus) Rank() int {
+	switch s { //nolint:exhaustive
+	case MessageQueued:
+		return 1
+	case MessageSent:
+		return 2 //nolint:gomnd
+	case MessageDelivered:
+		return 3 //nolint:gomnd
+	case MessageRead:
+		return 4 //nolint:gomnd
+	case MessageFailed, MessageUndelivered:
+		return 5 //nolint:gomnd
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+type ScheduledMessageStatus string
+
+const (
+	ScheduledMessagePending  ScheduledMessageStatus = "pending"
+	ScheduledMessageSent     ScheduledMessageStatus = "sent"
+	ScheduledMessageCanceled ScheduledMessageStatus = "canceled"
+	ScheduledMessageFailed   ScheduledMessageStatus = "failed"
+)
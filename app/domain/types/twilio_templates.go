@@ -1,8 +0,0 @@
-package types
-
-type TwilioTemplate string
-
-const (
-	QuickResponseTemplate TwilioTemplate = "HX1ea028af1b1903fff0f470367d41469c"
-	ListTemplate          TwilioTemplate = "HXb936d764172f2fdf4c73dcb6bc150631"
-)
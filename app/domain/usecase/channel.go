@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+// userProvider is the channel messages to the user are routed through.
+func userProvider(user entity.User) dto.Provider {
+	if user.PreferredChannel == "" {
+		return dto.WhatsappProvider
+	}
+
+	return dto.Provider(user.PreferredChannel)
+}
+
+// messenger returns the adapter serving the channel.
+func (u *UseCase) messenger(provider dto.Provider) (Messenger, error) {
+	const operation = "UseCase.messenger"
+
+	messenger, ok := u.Messengers[provider]
+	if !ok {
+		return nil, fmt.Errorf("%s (%s) -> %w", operation, provider, erring.ErrChannelNotSupported)
+	}
+
+	return messenger, nil
+}
+
+// updatePreferredChannel makes the channel the user wrote from the one they
+// are answered on. Messages queued before the channel was known keep it.
+func (u *UseCase) updatePreferredChannel(ctx context.Context, user entity.User, provider dto.Provider) (entity.User, error) {
+	const operation = "UseCase.updatePreferredChannel"
+
+	if provider == "" || provider == userProvider(user) {
+		return user, nil
+	}
+
+	err := u.UsersRepository.UpdatePreferredChannel(ctx, user.ID, string(provider))
+	if err != nil {
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	user.PreferredChannel = string(provider)
+
+	return user, nil
+}
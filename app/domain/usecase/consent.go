@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+type Consent struct {
+	// Keywords are matched against the whole message, ignoring case.
+	OptOutKeywords []string
+	OptInKeywords  []string
+
+	// Confirmations sent when the consent changes. Skipped when empty.
+	OptOutReply string
+	OptInReply  string
+}
+
+// handleConsentKeyword updates the user consent when the message is one of
+// the opt-out or opt-in keywords and reports whether it was.
+func (u *UseCase) handleConsentKeyword(ctx context.Context, user entity.User, message string) (bool, error) {
+	const operation = "UseCase.handleConsentKeyword"
+
+	keyword := strings.ToUpper(strings.TrimSpace(message))
+
+	var (
+		consent types.Consent
+		reply   string
+	)
+
+	switch {
+	case containsKeyword(u.Consent.OptOutKeywords, keyword):
+		consent, reply = types.ConsentOptedOut, u.Consent.OptOutReply
+	case containsKeyword(u.Consent.OptInKeywords, keyword):
+		consent, reply = types.ConsentOptedIn, u.Consent.OptInReply
+	default:
+		return false, nil
+	}
+
+	err := u.UsersRepository.UpdateConsent(ctx, entity.ConsentEvent{
+		UserID:  user.ID,
+		Consent: consent,
+		Source:  types.ConsentSourceKeyword,
+		Keyword: keyword,
+	})
+	if err != nil {
+		return false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if reply == "" || consent == user.Consent {
+		return true, nil
+	}
+
+	user.Consent = consent
+
+	err = u.sendUserMessage(ctx, user, dto.SendMessageInput{
+		Provider:          userProvider(user),
+		DestinationNumber: user.PhoneNumber,
+		Message:           reply,
+		Transactional:     true,
+	})
+	if err != nil {
+		return false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return true, nil
+}
+
+func containsKeyword(keywords []string, keyword string) bool {
+	return slices.ContainsFunc(keywords, func(candidate string) bool {
+		return strings.EqualFold(strings.TrimSpace(candidate), keyword)
+	})
+}
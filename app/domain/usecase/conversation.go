@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+const conversationCacheKeyPrefix = "conversation:"
+
+// advanceConversation moves the user through the flow according to the message
+// and sends the reply of the node the user lands on. When the user picked an
+// interactive option, its id and title are matched before the message body.
+// The conversation, the handoff the node asks for and the processed message
+// are saved together.
+func (u *UseCase) advanceConversation(ctx context.Context, user entity.User, message entity.UserMessage, reply *dto.InteractiveReply) error {
+	const operation = "UseCase.advanceConversation"
+
+	answer := message.Message
+
+	now := time.Now()
+
+	conversation, found, err := u.getConversation(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	flow, ok := u.Flows[conversation.FlowID]
+	current, known := flow.Node(conversation.CurrentNode)
+
+	restart := !found || !ok || !known ||
+		current.IsFinal() ||
+		conversation.IsExpired(now, u.ConversationTimeout)
+
+	var node entity.FlowNode
+
+	switch {
+	case restart:
+		flow, ok = u.Flows[u.DefaultFlowID]
+		if !ok {
+			return fmt.Errorf("%s (%s) -> %w", operation, u.DefaultFlowID, erring.ErrFlowNotFound)
+		}
+
+		conversation = entity.Conversation{
+			UserID:    user.ID,
+			FlowID:    flow.ID,
+			Variables: map[string]string{},
+		}
+
+		node, ok = flow.Node(flow.StartNode)
+		if !ok {
+			return fmt.Errorf("%s (%s) -> %w", operation, flow.StartNode, erring.ErrFlowNodeNotFound)
+		}
+	default:
+		answers := []string{answer}
+		if reply != nil {
+			answers = []string{reply.ID, reply.Title, answer}
+		}
+
+		nextID, matched := current.Next(answers...)
+		if !matched {
+			node = current
+			if current.Fallback != "" {
+				node = entity.FlowNode{ID: current.ID, Message: current.Fallback}
+			}
+
+			break
+		}
+
+		if current.SaveAs != "" {
+			conversation.Variables[current.SaveAs] = strings.TrimSpace(answer)
+		}
+
+		node, ok = flow.Node(nextID)
+		if !ok {
+			return fmt.Errorf("%s (%s) -> %w", operation, nextID, erring.ErrFlowNodeNotFound)
+		}
+	}
+
+	err = u.sendFlowNode(ctx, user, node, conversation.Variables)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	conversation.CurrentNode = node.ID
+	conversation.LastActivityAt = now
+
+	var handoffReason string
+	if node.Handoff {
+		handoffReason = flow.ID + "/" + node.ID
+	}
+
+	err = u.saveConversation(ctx, conversation, message.ID, handoffReason)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
+
+func (u *UseCase) sendFlowNode(ctx context.Context, user entity.User, node entity.FlowNode, variables map[string]string) error {
+	const operation = "UseCase.sendFlowNode"
+
+	replacer := flowVariablesReplacer(user, variables)
+
+	var err error
+
+	switch {
+	case node.Template != "":
+		templateVariables := make(map[string]string, len(node.TemplateVariables))
+		for key, value := range node.TemplateVariables {
+			templateVariables[key] = replacer.Replace(value)
+		}
+
+		err = u.sendUserMessageTemplate(ctx, user, dto.SendMessageTemplateInput{
+			Provider:          userProvider(user),
+			DestinationNumber: user.PhoneNumber,
+			TemplateName:      node.Template,
+			Variables:         templateVariables,
+		})
+	case node.MediaURL != "":
+		err = u.sendUserMedia(ctx, user, dto.SendMediaInput{
+			Provider:          userProvider(user),
+			DestinationNumber: user.PhoneNumber,
+			MediaURL:          node.MediaURL,
+			Caption:           replacer.Replace(node.Message),
+		})
+	default:
+		err = u.sendUserMessage(ctx, user, dto.SendMessageInput{
+			Provider:          userProvider(user),
+			DestinationNumber: user.PhoneNumber,
+			Message:           replacer.Replace(node.Message),
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s (%s) -> %w", operation, node.ID, err)
+	}
+
+	return nil
+}
+
+// getConversation reads the conversation from the cache, falling back to
+// Postgres when it is missing or the cache is unavailable.
+func (u *UseCase) getConversation(ctx context.Context, userID string) (entity.Conversation, bool, error) {
+	const operation = "UseCase.getConversation"
+
+	var conversation entity.Conversation
+
+	err := u.Cache.Get(ctx, conversationCacheKeyPrefix+userID, &conversation)
+	if err == nil {
+		return conversation, true, nil
+	}
+
+	if !errors.Is(err, erring.ErrCacheKeyDoesNotExist) {
+		slog.WarnContext(ctx, fmt.Errorf("%s -> %w", operation, err).Error())
+	}
+
+	conversation, err = u.ConversationsRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, erring.ErrConversationNotFound) {
+			return entity.Conversation{}, false, nil
+		}
+
+		return entity.Conversation{}, false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if conversation.Variables == nil {
+		conversation.Variables = map[string]string{}
+	}
+
+	return conversation, true, nil
+}
+
+// saveConversation stores the conversation in Postgres, then in the cache.
+// When the cache cannot be updated its copy is dropped, so the next message
+// reads the conversation from Postgres instead of a stale step.
+func (u *UseCase) saveConversation(ctx context.Context, conversation entity.Conversation, messageID, handoffReason string) error {
+	const operation = "UseCase.saveConversation"
+
+	err := u.ConversationsRepository.Advance(ctx, conversation, messageID, handoffReason)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	key := conversationCacheKeyPrefix + conversation.UserID
+
+	err = u.Cache.Set(ctx, key, conversation, u.ConversationTimeout)
+	if err != nil {
+		if _, delErr := u.Cache.Del(ctx, key); delErr != nil {
+			err = errors.Join(err, delErr)
+		}
+
+		slog.WarnContext(ctx, fmt.Errorf("%s -> %w", operation, err).Error())
+	}
+
+	return nil
+}
+
+func flowVariablesReplacer(user entity.User, variables map[string]string) *strings.Replacer {
+	pairs := []string{"{{name}}", user.Name}
+
+	for key, value := range variables {
+		pairs = append(pairs, "{{"+key+"}}", value)
+	}
+
+	return strings.NewReplacer(pairs...)
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+type CreateCampaignInput struct {
+	Name         string
+	TemplateName string
+	Locale       string
+	Audience     entity.CampaignAudience
+	Variables    map[string]string
+
+	// Schedules the campaign right away when set, otherwise it is created as
+	// a draft.
+	ScheduledAt *time.Time
+}
+
+// CreateCampaign checks that the template exists and that every variable it
+// declares is mapped to a known user field before storing the campaign. The
+// audience phone prefixes are stored in the E.164 form of the phone numbers.
+func (u *UseCase) CreateCampaign(ctx context.Context, input CreateCampaignInput) (entity.Campaign, error) {
+	const operation = "UseCase.CreateCampaign"
+
+	if input.Locale == "" {
+		input.Locale = u.DefaultLocale
+	}
+
+	template, err := u.TemplatesRepository.Get(ctx, input.TemplateName, input.Locale, string(dto.WhatsappProvider))
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	names := make([]string, 0, len(input.Variables))
+
+	for variable, field := range input.Variables {
+		if _, ok := (entity.User{}).Field(field); !ok {
+			return entity.Campaign{}, fmt.Errorf("%s (%s: %s) -> %w", operation, variable, field, erring.ErrCampaignVariablesInvalid)
+		}
+
+		names = append(names, variable)
+	}
+
+	// The values are only known per user, so only the mapping is checked to
+	// cover the template. The resolved values are validated on every send.
+	if err := template.ValidateVariableNames(names); err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s (%s) -> %w: %w", operation, template.Name, erring.ErrTemplateVariablesInvalid, err)
+	}
+
+	prefixes := make([]string, 0, len(input.Audience.PhonePrefixes))
+	for _, prefix := range input.Audience.PhonePrefixes {
+		if prefix = phoneNumberPrefix(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	input.Audience.PhonePrefixes = prefixes
+
+	status := types.CampaignDraft
+	if input.ScheduledAt != nil {
+		status = types.CampaignScheduled
+	}
+
+	campaign, err := u.CampaignsRepository.Create(ctx, entity.Campaign{
+		Name:         input.Name,
+		TemplateName: input.TemplateName,
+		Locale:       input.Locale,
+		Audience:     input.Audience,
+		Variables:    input.Variables,
+		Status:       status,
+		ScheduledAt:  input.ScheduledAt,
+	})
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return campaign, nil
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/phone"
+	"github.com/chatbot-go/app/library/timezone"
+)
+
+type CreateUserInput struct {
+	Name        string
+	PhoneNumber string
+
+	// Derived from the phone number when empty.
+	TimeZone string
+
+	// WhatsApp when empty.
+	Channel string
+}
+
+// CreateUser stores a user unless another one already has the phone number.
+func (u *UseCase) CreateUser(ctx context.Context, input CreateUserInput) (entity.User, error) {
+	const operation = "UseCase.CreateUser"
+
+	phoneNumber, err := phone.Normalize(input.PhoneNumber)
+	if err != nil {
+		return entity.User{}, fmt.Errorf("%s -> %w: %w", operation, erring.ErrRequestInvalid, err)
+	}
+
+	input.PhoneNumber = phoneNumber
+
+	if err := u.checkPhoneNumberAvailable(ctx, input.PhoneNumber, ""); err != nil {
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if input.TimeZone == "" {
+		input.TimeZone, _ = timezone.FromPhoneNumber(input.PhoneNumber)
+	}
+
+	user, err := u.UsersRepository.Create(ctx, entity.User{
+		Name:        input.Name,
+		PhoneNumber: input.PhoneNumber,
+		TimeZone:    input.TimeZone,
+
+		PreferredChannel: input.Channel,
+	})
+	if err != nil {
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return user, nil
+}
+
+// checkPhoneNumberAvailable fails when a user other than userID already has
+// the phone number.
+func (u *UseCase) checkPhoneNumberAvailable(ctx context.Context, phoneNumber, userID string) error {
+	const operation = "UseCase.checkPhoneNumberAvailable"
+
+	user, err := u.UsersRepository.GetByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, erring.ErrUserNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if user.ID != userID {
+		return fmt.Errorf("%s -> %w", operation, erring.ErrUserAlreadyExists)
+	}
+
+	return nil
+}
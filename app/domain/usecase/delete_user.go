@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+)
+
+func (u *UseCase) DeleteUser(ctx context.Context, id string) error {
+	const operation = "UseCase.DeleteUser"
+
+	if err := u.UsersRepository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/phone"
+)
+
+// EnqueueInboundMessage queues a message already parsed by the adapter of
+// its provider, with the sender number normalised to E.164, for the tenant
+// in the context.
+func (u *UseCase) EnqueueInboundMessage(ctx context.Context, message dto.InboundMessage) error {
+	const operation = "UseCase.EnqueueInboundMessage"
+
+	if message.Provider != dto.SMSProvider && message.Provider != dto.WhatsappProvider {
+		return fmt.Errorf("%s (%s) -> %w", operation, message.Provider, erring.ErrEventInvalid)
+	}
+
+	_, phoneNumber, err := phone.ParseAddress(message.PhoneNumber)
+	if err != nil {
+		return fmt.Errorf("%s (%s) -> %w: %w", operation, message.PhoneNumber, erring.ErrEventInvalid, err)
+	}
+
+	message.PhoneNumber = phoneNumber
+	message.TenantID = entity.TenantIDFromContext(ctx)
+
+	err = u.Enqueuer.WebhooksTwilio(ctx, message)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
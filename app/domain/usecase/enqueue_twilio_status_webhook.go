@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+type EnqueueTwilioStatusWebhookInput struct {
+	MessageSid    string
+	MessageStatus string
+	ErrorCode     string
+}
+
+func (u *UseCase) EnqueueTwilioStatusWebhook(ctx context.Context, input EnqueueTwilioStatusWebhookInput) error {
+	const operation = "UseCase.EnqueueTwilioStatusWebhook"
+
+	update := dto.MessageStatusUpdate{
+		MessageSid: input.MessageSid,
+		Status:     input.MessageStatus,
+		ErrorCode:  input.ErrorCode,
+	}
+
+	err := u.EnqueueMessageStatus(ctx, update)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
+
+// EnqueueMessageStatus queues a delivery status already parsed by the adapter
+// of its provider, for the tenant in the context.
+func (u *UseCase) EnqueueMessageStatus(ctx context.Context, update dto.MessageStatusUpdate) error {
+	const operation = "UseCase.EnqueueMessageStatus"
+
+	update.TenantID = entity.TenantIDFromContext(ctx)
+
+	err := u.Enqueuer.WebhooksTwilioStatus(ctx, update)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
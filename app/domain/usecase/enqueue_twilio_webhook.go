@@ -9,21 +9,53 @@ import (
 )
 
 type EnqueueTwilioWebhookInput struct {
-	MessageBody string
-	MessageSid  string
-	PhoneNumber string
+	MessageBody   string
+	MessageSid    string
+	PhoneNumber   string
+	ProfileName   string
+	WaID          string
+	ButtonPayload string
+	ButtonText    string
+	ListID        string
+	ListTitle     string
+	Media         []dto.Media
+}
+
+func (i EnqueueTwilioWebhookInput) interactiveReply() *dto.InteractiveReply {
+	switch {
+	case i.ButtonPayload != "" || i.ButtonText != "":
+		return &dto.InteractiveReply{Type: dto.ButtonReply, ID: i.ButtonPayload, Title: i.ButtonText}
+	case i.ListID != "":
+		return &dto.InteractiveReply{Type: dto.ListReply, ID: i.ListID, Title: i.ListTitle}
+	default:
+		return nil
+	}
 }
 
 func (u *UseCase) EnqueueTwilioWebhook(ctx context.Context, input EnqueueTwilioWebhookInput) error {
 	const operation = "UseCase.EnqueueTwilioWebhook"
 
-	webhook := dto.WebhookTwilio{
+	channel, _, found := strings.Cut(input.PhoneNumber, ":")
+
+	// SMS senders come without a channel prefix.
+	provider := dto.SMSProvider
+	if found {
+		provider = dto.Provider(strings.ToLower(strings.TrimSpace(channel)))
+	}
+
+	message := dto.InboundMessage{
 		MessageSid:  input.MessageSid,
 		MessageBody: input.MessageBody,
-		PhoneNumber: strings.Split(input.PhoneNumber, ":")[1],
+		PhoneNumber: input.PhoneNumber,
+		ProfileName: input.ProfileName,
+		WaID:        input.WaID,
+		Provider:    provider,
+
+		InteractiveReply: input.interactiveReply(),
+		Media:            input.Media,
 	}
 
-	err := u.Enqueuer.WebhooksTwilio(ctx, webhook)
+	err := u.EnqueueInboundMessage(ctx, message)
 	if err != nil {
 		return fmt.Errorf("%s -> %w", operation, err)
 	}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+var ErrExportFormatInvalid = errors.New("export format must be csv or jsonl")
+
+type ExportConversationsInput struct {
+	// Closed when the export ends, before the run is finished, so a failed
+	// write or close fails the run.
+	Output io.WriteCloser
+	Format types.ExportFormat
+
+	// Where the output goes, recorded in the export run.
+	Destination string
+
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UserID      string
+}
+
+// exportedMessage is a row of an export, with the json names also used as
+// the csv header.
+type exportedMessage struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	UserName     string    `json:"user_name"`
+	PhoneNumber  string    `json:"phone_number"`
+	Direction    string    `json:"direction"`
+	Message      string    `json:"message"`
+	TemplateName string    `json:"template_name"`
+	Status       string    `json:"status"`
+	ErrorCode    string    `json:"error_code"`
+	TwilioSID    string    `json:"twilio_sid"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+var exportedMessageHeader = []string{
+	"id", "user_id", "user_name", "phone_number", "direction", "message",
+	"template_name", "status", "error_code", "twilio_sid", "created_at",
+}
+
+func (m exportedMessage) record() []string {
+	return []string{
+		m.ID, m.UserID, m.UserName, m.PhoneNumber, m.Direction, m.Message,
+		m.TemplateName, m.Status, m.ErrorCode, m.TwilioSID, m.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+// ExportConversations streams the messages of the users, with their name and
+// phone number, to the output as CSV or JSONL. Every run is recorded with
+// its filters and outcome, also when it fails.
+func (u *UseCase) ExportConversations(ctx context.Context, input ExportConversationsInput) (entity.ExportRun, error) {
+	const operation = "UseCase.ExportConversations"
+
+	write, flush, err := exportWriter(input.Output, input.Format)
+	if err != nil {
+		return entity.ExportRun{}, fmt.Errorf("%s -> %w", operation, errors.Join(err, input.Output.Close()))
+	}
+
+	run, err := u.ExportRunsRepository.Create(ctx, entity.ExportRun{
+		Format:      input.Format,
+		Destination: input.Destination,
+		CreatedFrom: input.CreatedFrom,
+		CreatedTo:   input.CreatedTo,
+		UserID:      input.UserID,
+	})
+	if err != nil {
+		return entity.ExportRun{}, fmt.Errorf("%s -> %w", operation, errors.Join(err, input.Output.Close()))
+	}
+
+	filter := dto.UserMessagesExport{
+		CreatedFrom: input.CreatedFrom,
+		CreatedTo:   input.CreatedTo,
+		UserID:      input.UserID,
+	}
+
+	exportErr := u.UserMessagesRepository.Export(ctx, filter, func(message entity.UserMessage, user entity.User) error {
+		err := write(exportedMessage{
+			ID:           message.ID,
+			UserID:       user.ID,
+			UserName:     user.Name,
+			PhoneNumber:  user.PhoneNumber,
+			Direction:    string(message.Direction),
+			Message:      message.Message,
+			TemplateName: message.TemplateName,
+			Status:       string(message.Status),
+			ErrorCode:    message.ErrorCode,
+			TwilioSID:    message.TwilioSID,
+			CreatedAt:    message.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		run.RowsExported++
+
+		return nil
+	})
+	if exportErr == nil {
+		exportErr = flush()
+	}
+
+	if err := input.Output.Close(); exportErr == nil {
+		exportErr = err
+	}
+
+	run.Status = types.ExportRunCompleted
+	if exportErr != nil {
+		run.Status = types.ExportRunFailed
+		run.Error = exportErr.Error()
+	}
+
+	// Still recorded when the export was interrupted.
+	run, err = u.ExportRunsRepository.Finish(context.WithoutCancel(ctx), run)
+	if err != nil {
+		return entity.ExportRun{}, fmt.Errorf("%s -> %w", operation, errors.Join(exportErr, err))
+	}
+
+	if exportErr != nil {
+		return run, fmt.Errorf("%s -> %w", operation, exportErr)
+	}
+
+	return run, nil
+}
+
+// exportWriter returns the functions writing rows in the format and flushing
+// what is buffered.
+func exportWriter(output io.Writer, format types.ExportFormat) (func(exportedMessage) error, func() error, error) {
+	switch format {
+	case types.ExportJSONL:
+		encoder := json.NewEncoder(output)
+
+		write := func(message exportedMessage) error {
+			return encoder.Encode(message) //nolint:wrapcheck
+		}
+
+		return write, func() error { return nil }, nil
+	case types.ExportCSV:
+		writer := csv.NewWriter(output)
+		headerWritten := false
+
+		write := func(message exportedMessage) error {
+			if !headerWritten {
+				if err := writer.Write(exportedMessageHeader); err != nil {
+					return err //nolint:wrapcheck
+				}
+
+				headerWritten = true
+			}
+
+			return writer.Write(message.record()) //nolint:wrapcheck
+		}
+
+		flush := func() error {
+			if !headerWritten {
+				if err := writer.Write(exportedMessageHeader); err != nil {
+					return err //nolint:wrapcheck
+				}
+			}
+
+			writer.Flush()
+
+			return writer.Error() //nolint:wrapcheck
+		}
+
+		return write, flush, nil
+	default:
+		return nil, nil, ErrExportFormatInvalid
+	}
+}
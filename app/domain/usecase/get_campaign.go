@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+func (u *UseCase) GetCampaign(ctx context.Context, id string) (entity.Campaign, error) {
+	const operation = "UseCase.GetCampaign"
+
+	campaign, err := u.CampaignsRepository.GetByID(ctx, id)
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return campaign, nil
+}
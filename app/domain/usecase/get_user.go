@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+func (u *UseCase) GetUser(ctx context.Context, id string) (entity.User, error) {
+	const operation = "UseCase.GetUser"
+
+	user, err := u.UsersRepository.GetByID(ctx, id)
+	if err != nil {
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// handoffHistoryLimit is how many of the latest messages agents get when
+// reading a conversation.
+const handoffHistoryLimit = 100
+
+// hasActiveHandoff reports whether the user conversation is with a human
+// agent, in which case the bot stays silent.
+func (u *UseCase) hasActiveHandoff(ctx context.Context, user entity.User) (bool, error) {
+	const operation = "UseCase.hasActiveHandoff"
+
+	_, err := u.HandoffsRepository.GetActiveByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, erring.ErrHandoffNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return true, nil
+}
+
+func (u *UseCase) ListHandoffs(ctx context.Context, status types.HandoffStatus) ([]entity.Handoff, error) {
+	const operation = "UseCase.ListHandoffs"
+
+	handoffs, err := u.HandoffsRepository.List(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return handoffs, nil
+}
+
+func (u *UseCase) ClaimHandoff(ctx context.Context, id, agentID string) (entity.Handoff, error) {
+	const operation = "UseCase.ClaimHandoff"
+
+	err := u.HandoffsRepository.Claim(ctx, id, agentID)
+	if err != nil {
+		return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	handoff, err := u.HandoffsRepository.GetByID(ctx, id)
+	if err != nil {
+		return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return handoff, nil
+}
+
+// ListHandoffMessages returns the latest messages exchanged with the user of
+// the handoff.
+func (u *UseCase) ListHandoffMessages(ctx context.Context, id string) ([]entity.UserMessage, error) {
+	const operation = "UseCase.ListHandoffMessages"
+
+	handoff, err := u.HandoffsRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	messages, err := u.UserMessagesRepository.ListByUserID(ctx, handoff.User.ID, handoffHistoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return messages, nil
+}
+
+// ReplyHandoff sends a free-form message from the agent who claimed the
+// handoff. Replies are transactional since the user started the conversation.
+// Rate limited replies are queued for the workers to send once the limit
+// lets them through.
+func (u *UseCase) ReplyHandoff(ctx context.Context, id, agentID, message string) error {
+	const operation = "UseCase.ReplyHandoff"
+
+	handoff, err := u.HandoffsRepository.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if handoff.Status != types.HandoffClaimed || handoff.AgentID != agentID {
+		return fmt.Errorf("%s (%s) -> %w", operation, id, erring.ErrHandoffNotClaimedByAgent)
+	}
+
+	err = u.sendUserMessage(ctx, handoff.User, dto.SendMessageInput{
+		Provider:          userProvider(handoff.User),
+		DestinationNumber: handoff.User.PhoneNumber,
+		Message:           message,
+		Transactional:     true,
+	})
+
+	var sqsEventErr *erring.SQSEventError
+	if errors.As(err, &sqsEventErr) {
+		err = u.Enqueuer.Outbound(ctx, dto.OutboundMessage{
+			TenantID:      entity.TenantIDFromContext(ctx),
+			UserID:        handoff.User.ID,
+			Message:       message,
+			Transactional: true,
+			Key:           "handoff-reply:" + handoff.ID + ":" + uuid.NewString(),
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	err = u.HandoffsRepository.CreateEvent(ctx, entity.HandoffEvent{
+		HandoffID: handoff.ID,
+		Action:    types.HandoffActionReplied,
+		AgentID:   agentID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
+
+// CloseHandoff gives the conversation back to the bot.
+func (u *UseCase) CloseHandoff(ctx context.Context, id, agentID string) (entity.Handoff, error) {
+	const operation = "UseCase.CloseHandoff"
+
+	handoff, err := u.HandoffsRepository.GetByID(ctx, id)
+	if err != nil {
+		return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if handoff.Status == types.HandoffClaimed && handoff.AgentID != agentID {
+		return entity.Handoff{}, fmt.Errorf("%s (%s) -> %w", operation, id, erring.ErrHandoffNotClaimedByAgent)
+	}
+
+	err = u.HandoffsRepository.Close(ctx, id, agentID)
+	if err != nil {
+		return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	handoff, err = u.HandoffsRepository.GetByID(ctx, id)
+	if err != nil {
+		return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return handoff, nil
+}
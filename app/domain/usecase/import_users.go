@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/library/phone"
+	"github.com/chatbot-go/app/library/timezone"
+)
+
+const (
+	importUsersDefaultBatchSize = 1000
+	importUsersMaxNameLength    = 200
+)
+
+var ErrImportUsersHeaderInvalid = errors.New("csv header must have the name and phone columns")
+
+type ImportUsersInput struct {
+	// CSV with a header row. The name and phone columns are required, any
+	// other column is stored as a user attribute.
+	File io.Reader
+
+	BatchSize int
+
+	// Validates and counts the rows without writing them.
+	DryRun bool
+}
+
+type ImportUsersReport struct {
+	Inserted int
+	Updated  int
+	Rejected []ImportUsersRejection
+}
+
+type ImportUsersRejection struct {
+	// Line in the file, the header being line 1.
+	Line   int
+	Reason string
+}
+
+type importUsersColumns struct {
+	name       int
+	phone      int
+	attributes map[int]string
+}
+
+// ImportUsers streams a CSV of users and upserts them by phone number in
+// batches. Invalid rows are rejected with their reason and do not stop the
+// import.
+func (u *UseCase) ImportUsers(ctx context.Context, input ImportUsersInput) (ImportUsersReport, error) {
+	const operation = "UseCase.ImportUsers"
+
+	if input.BatchSize <= 0 {
+		input.BatchSize = importUsersDefaultBatchSize
+	}
+
+	reader := csv.NewReader(input.File)
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return ImportUsersReport{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	columns, err := parseImportUsersHeader(header)
+	if err != nil {
+		return ImportUsersReport{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	var (
+		report ImportUsersReport
+		batch  = make([]entity.User, 0, input.BatchSize)
+		// Line of the first row of each phone number across the whole file,
+		// so later rows of the same user are rejected instead of overwriting
+		// it. It holds one entry per accepted row, around a hundred bytes
+		// each, so a million-row file keeps about 100MB in memory.
+		seen = map[string]int{}
+	)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		result, err := u.UsersRepository.Import(ctx, batch, input.DryRun)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		report.Inserted += result.Inserted
+		report.Updated += result.Updated
+		batch = batch[:0]
+
+		return nil
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			report.Rejected = append(report.Rejected, ImportUsersRejection{Line: parseErr.StartLine, Reason: parseErr.Err.Error()})
+
+			continue
+		}
+
+		if err != nil {
+			return report, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		user, reason := parseImportUsersRecord(record, columns)
+		if reason == "" {
+			if first, ok := seen[user.PhoneNumber]; ok {
+				reason = fmt.Sprintf("duplicate phone number, first seen on line %d", first)
+			}
+		}
+
+		if reason != "" {
+			report.Rejected = append(report.Rejected, ImportUsersRejection{Line: line, Reason: reason})
+
+			continue
+		}
+
+		seen[user.PhoneNumber] = line
+		batch = append(batch, user)
+
+		if len(batch) == input.BatchSize {
+			if err := flush(); err != nil {
+				return report, fmt.Errorf("%s (line %d) -> %w", operation, line, err)
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return report, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return report, nil
+}
+
+func parseImportUsersHeader(header []string) (importUsersColumns, error) {
+	columns := importUsersColumns{name: -1, phone: -1, attributes: map[int]string{}}
+
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+
+		switch column {
+		case "name":
+			columns.name = i
+		case "phone", "phone_number":
+			columns.phone = i
+		case "":
+		default:
+			columns.attributes[i] = column
+		}
+	}
+
+	if columns.name < 0 || columns.phone < 0 {
+		return importUsersColumns{}, ErrImportUsersHeaderInvalid
+	}
+
+	return columns, nil
+}
+
+// parseImportUsersRecord builds the user of a row, or returns why it is
+// rejected.
+func parseImportUsersRecord(record []string, columns importUsersColumns) (entity.User, string) {
+	name := strings.TrimSpace(record[columns.name])
+
+	switch {
+	case name == "":
+		return entity.User{}, "name is empty"
+	case utf8.RuneCountInString(name) > importUsersMaxNameLength:
+		return entity.User{}, fmt.Sprintf("name is longer than %d characters", importUsersMaxNameLength)
+	}
+
+	phoneNumber, err := phone.Normalize(record[columns.phone])
+	if err != nil {
+		return entity.User{}, fmt.Sprintf("invalid phone number %q", record[columns.phone])
+	}
+
+	timeZone, _ := timezone.FromPhoneNumber(phoneNumber)
+
+	attributes := make(map[string]string, len(columns.attributes))
+	for i, attribute := range columns.attributes {
+		if value := strings.TrimSpace(record[i]); value != "" {
+			attributes[attribute] = value
+		}
+	}
+
+	return entity.User{
+		Name:        name,
+		PhoneNumber: phoneNumber,
+		TimeZone:    timeZone,
+		Attributes:  attributes,
+	}, ""
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/cursor"
+)
+
+type ListUserMessagesInput struct {
+	UserID      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Limit       int
+
+	// Cursor returned with the previous page, empty for the first one.
+	Cursor string
+}
+
+type ListUserMessagesOutput struct {
+	Messages []entity.UserMessage
+
+	// Empty on the last page.
+	NextCursor string
+}
+
+// ListUserMessages returns the conversation history of a user in
+// chronological order. Pages are keyed on the creation time and id of the
+// last message, so messages received while paging are not skipped.
+func (u *UseCase) ListUserMessages(ctx context.Context, input ListUserMessagesInput) (ListUserMessagesOutput, error) {
+	const operation = "UseCase.ListUserMessages"
+
+	if _, err := u.UsersRepository.GetByID(ctx, input.UserID); err != nil {
+		return ListUserMessagesOutput{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	filter := dto.UserMessagesFilter{
+		UserID:      input.UserID,
+		CreatedFrom: input.CreatedFrom,
+		CreatedTo:   input.CreatedTo,
+		// One more than asked tells whether there is a next page.
+		Limit: input.Limit + 1,
+	}
+
+	if input.Cursor != "" {
+		values, err := cursor.Decode(input.Cursor, 2) //nolint:gomnd
+		if err != nil {
+			return ListUserMessagesOutput{}, fmt.Errorf("%s -> %w: %w", operation, erring.ErrRequestInvalid, err)
+		}
+
+		createdAt, err := time.Parse(time.RFC3339Nano, values[0])
+		if err != nil {
+			return ListUserMessagesOutput{}, fmt.Errorf("%s -> %w: %w", operation, erring.ErrRequestInvalid, cursor.ErrInvalid)
+		}
+
+		filter.AfterCreatedAt = createdAt
+		filter.AfterID = values[1]
+	}
+
+	messages, err := u.UserMessagesRepository.List(ctx, filter)
+	if err != nil {
+		return ListUserMessagesOutput{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	output := ListUserMessagesOutput{Messages: messages}
+
+	if len(messages) > input.Limit {
+		output.Messages = messages[:input.Limit]
+		last := output.Messages[input.Limit-1]
+		output.NextCursor = cursor.Encode(last.CreatedAt.Format(time.RFC3339Nano), last.ID)
+	}
+
+	return output, nil
+}
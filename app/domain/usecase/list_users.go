@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/cursor"
+	"github.com/chatbot-go/app/library/util"
+)
+
+type ListUsersInput struct {
+	Name        string
+	PhoneNumber string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Limit       int
+
+	// Cursor returned with the previous page, empty for the first one.
+	Cursor string
+}
+
+type ListUsersOutput struct {
+	Users []entity.User
+
+	// Empty on the last page.
+	NextCursor string
+}
+
+// ListUsers returns a page of users using keyset pagination on the id, so
+// pages stay stable while users are created.
+func (u *UseCase) ListUsers(ctx context.Context, input ListUsersInput) (ListUsersOutput, error) {
+	const operation = "UseCase.ListUsers"
+
+	filter := dto.UsersFilter{
+		Name:        input.Name,
+		PhoneNumber: phoneNumberPrefix(input.PhoneNumber),
+		CreatedFrom: input.CreatedFrom,
+		CreatedTo:   input.CreatedTo,
+		// One more than asked tells whether there is a next page.
+		Limit: input.Limit + 1,
+	}
+
+	if input.Cursor != "" {
+		values, err := cursor.Decode(input.Cursor, 1)
+		if err != nil {
+			return ListUsersOutput{}, fmt.Errorf("%s -> %w: %w", operation, erring.ErrRequestInvalid, err)
+		}
+
+		filter.AfterID = values[0]
+	}
+
+	users, err := u.UsersRepository.List(ctx, filter)
+	if err != nil {
+		return ListUsersOutput{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	output := ListUsersOutput{Users: users}
+
+	if len(users) > input.Limit {
+		output.Users = users[:input.Limit]
+		output.NextCursor = cursor.Encode(output.Users[input.Limit-1].ID)
+	}
+
+	return output, nil
+}
+
+// phoneNumberPrefix matches the E.164 form the phone numbers are stored with.
+func phoneNumberPrefix(prefix string) string {
+	digits := util.KeepNumbers(prefix)
+	if digits == "" {
+		return ""
+	}
+
+	return "+" + digits
+}
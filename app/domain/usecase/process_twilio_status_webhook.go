@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+type ProcessTwilioStatusWebhookInput struct {
+	TenantID   string `json:"tenant_id"`
+	MessageSid string `json:"message_sid"`
+	Status     string `json:"status"`
+	ErrorCode  string `json:"error_code"`
+}
+
+func (u *UseCase) ProcessTwilioStatusWebhook(ctx context.Context, input ProcessTwilioStatusWebhookInput) error {
+	const operation = "UseCase.ProcessTwilioStatusWebhook"
+
+	status := types.MessageStatus(input.Status)
+
+	// Intermediate statuses such as accepted and sending are not tracked.
+	if status.Rank() == 0 {
+		return nil
+	}
+
+	err := u.UserMessagesRepository.UpdateStatus(ctx, input.MessageSid, status, input.ErrorCode)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -2,28 +2,149 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/chatbot-go/app/domain/dto"
 	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
 )
 
 type ProcessTwilioWebhookInput struct {
-	PhoneNumber string `json:"phone_number"`
-	MessageBody string `json:"message_body"`
+	TenantID         string                `json:"tenant_id"`
+	MessageSid       string                `json:"message_sid"`
+	PhoneNumber      string                `json:"phone_number"`
+	MessageBody      string                `json:"message_body"`
+	ProfileName      string                `json:"profile_name"`
+	WaID             string                `json:"wa_id"`
+	Provider         dto.Provider          `json:"provider"`
+	InteractiveReply *dto.InteractiveReply `json:"interactive_reply"`
+	Media            []dto.Media           `json:"media"`
 }
 
+// ProcessTwilioWebhook stores the inbound message and lets the bot handle it.
+// The message is marked processed in the same step that ends its handling, so
+// a webhook delivered again after a failure resumes the message instead of
+// being dropped.
 func (u *UseCase) ProcessTwilioWebhook(ctx context.Context, input ProcessTwilioWebhookInput) error {
 	const operation = "UseCase.ProcessTwilioWebhook"
 
+	firstContact := false
+
 	user, err := u.UsersRepository.GetByPhoneNumber(ctx, input.PhoneNumber)
+	if err != nil {
+		if !errors.Is(err, erring.ErrUserNotFound) || !u.Onboarding.AutoRegister {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		user, err = u.registerUser(ctx, input)
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		firstContact = true
+	}
+
+	user, err = u.updatePreferredChannel(ctx, user, input.Provider)
 	if err != nil {
 		return fmt.Errorf("%s -> %w", operation, err)
 	}
 
-	err = u.UserMessagesRepository.Create(ctx, entity.UserMessage{
-		UserID:  user.ID,
-		Message: input.MessageBody,
+	message, err := u.UserMessagesRepository.Create(ctx, entity.UserMessage{
+		UserID:    user.ID,
+		Direction: types.InboundMessage,
+		Message:   input.MessageBody,
+		TwilioSID: input.MessageSid,
+		Status:    types.MessageReceived,
 	})
+	if err != nil {
+		if !errors.Is(err, erring.ErrUserMessageAlreadyExists) {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		// The webhook was delivered again. It is acknowledged only when the
+		// message was fully processed, and resumed otherwise.
+		message, err = u.UserMessagesRepository.GetByTwilioSID(ctx, input.MessageSid)
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		if message.ProcessedAt != nil {
+			return nil
+		}
+	}
+
+	// A user who sent nothing before this message is new as well when an
+	// earlier attempt registered them and then failed, so the welcome is
+	// queued again.
+	if !firstContact && u.Onboarding.WelcomeTemplate != "" {
+		sentBefore, err := u.UserMessagesRepository.ExistsInbound(ctx, user.ID, input.MessageSid)
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		firstContact = !sentBefore
+	}
+
+	welcome := firstContact && u.Onboarding.WelcomeTemplate != ""
+
+	if welcome {
+		err = u.welcomeUser(ctx, user)
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+	}
+
+	// Messages queued before the provider was recorded came from WhatsApp.
+	provider := input.Provider
+	if provider == "" {
+		provider = dto.WhatsappProvider
+	}
+
+	err = u.storeAttachments(ctx, provider, message, input.Media)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	handled, err := u.handleConsentKeyword(ctx, user, input.MessageBody)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	// The bot stays silent for users who opted out until they opt in again.
+	if handled || user.Consent == types.ConsentOptedOut {
+		return u.markProcessed(ctx, message)
+	}
+
+	// Agents reply through the agent API while the handoff is not closed.
+	inHandoff, err := u.hasActiveHandoff(ctx, user)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if inHandoff {
+		return u.markProcessed(ctx, message)
+	}
+
+	// The welcome greets new users instead of the start of the flow, which
+	// their next message begins.
+	if welcome {
+		return u.markProcessed(ctx, message)
+	}
+
+	err = u.advanceConversation(ctx, user, message, input.InteractiveReply)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
+
+func (u *UseCase) markProcessed(ctx context.Context, message entity.UserMessage) error {
+	const operation = "UseCase.markProcessed"
+
+	err := u.UserMessagesRepository.MarkProcessed(ctx, message.ID)
 	if err != nil {
 		return fmt.Errorf("%s -> %w", operation, err)
 	}
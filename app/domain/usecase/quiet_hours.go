@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// QuietHours is the daily window, in the user local time, in which no
+// broadcast is sent. The window may cross midnight.
+type QuietHours struct {
+	Start time.Duration
+	End   time.Duration
+
+	// Used for users without a time zone.
+	DefaultLocation *time.Location
+}
+
+// deferUntil returns when the quiet hours of the user end, if now falls
+// inside them.
+func (q QuietHours) deferUntil(now time.Time, user entity.User) (time.Time, bool) {
+	if q.Start == q.End {
+		return time.Time{}, false
+	}
+
+	local := now.In(q.location(user))
+	year, month, day := local.Date()
+	start := wallClock(year, month, day, q.Start, local.Location())
+	end := wallClock(year, month, day, q.End, local.Location())
+
+	switch {
+	case q.Start < q.End && !local.Before(start) && local.Before(end):
+		return end, true
+	case q.Start > q.End && !local.Before(start):
+		return wallClock(year, month, day+1, q.End, local.Location()), true
+	case q.Start > q.End && local.Before(end):
+		return end, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// wallClock returns the given time of day on the given date. It is built from
+// the clock reading rather than added to midnight, so DST days do not shift it.
+func wallClock(year int, month time.Month, day int, clock time.Duration, loc *time.Location) time.Time {
+	hour := int(clock / time.Hour)
+	minute := int(clock % time.Hour / time.Minute)
+
+	return time.Date(year, month, day, hour, minute, 0, 0, loc)
+}
+
+func (q QuietHours) location(user entity.User) *time.Location {
+	if user.TimeZone != "" {
+		if location, err := time.LoadLocation(user.TimeZone); err == nil {
+			return location
+		}
+	}
+
+	if q.DefaultLocation != nil {
+		return q.DefaultLocation
+	}
+
+	return time.UTC
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+type RateLimits struct {
+	// Sender of the tenants using the Twilio account of the config.
+	OriginNumber string
+
+	// Channels sent through Twilio, from the origin number. Only they are
+	// held to the number limits.
+	TwilioProviders map[dto.Provider]bool
+
+	Numbers  map[string]Rate
+	Channels map[string]Rate
+
+	// Longest a send blocks waiting for the limit. Beyond it the send is
+	// rescheduled through an erring.SQSEventError.
+	MaxWait time.Duration
+}
+
+type Rate struct {
+	Count  int
+	Period time.Duration
+}
+
+// waitRateLimit blocks until the limits of the tenant origin number, for
+// channels sent through Twilio, and of the tenant channel both let one more
+// message through. Sends are not held
+// back when the limiter itself is unavailable.
+func (u *UseCase) waitRateLimit(ctx context.Context, provider dto.Provider) error {
+	const operation = "UseCase.waitRateLimit"
+
+	var limits []dto.RateLimit
+
+	tenant, err := u.TenantsRepository.GetByID(ctx, entity.TenantIDFromContext(ctx))
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	originNumber := tenant.OriginNumber
+	if tenant.UsesDefaultAccount() {
+		originNumber = u.RateLimits.OriginNumber
+	}
+
+	if rate, ok := u.RateLimits.Numbers[originNumber]; ok && u.RateLimits.TwilioProviders[provider] {
+		limits = append(limits, dto.RateLimit{Key: "rate-limit:number:" + originNumber, Count: rate.Count, Period: rate.Period})
+	}
+
+	if rate, ok := u.RateLimits.Channels[string(provider)]; ok {
+		limits = append(limits, dto.RateLimit{Key: "rate-limit:channel:" + tenant.ID + ":" + string(provider), Count: rate.Count, Period: rate.Period})
+	}
+
+	if len(limits) == 0 {
+		return nil
+	}
+
+	var waited time.Duration
+
+	for {
+		// Both limits are taken together, so waiting on one does not use up
+		// the other.
+		wait, err := u.RateLimiter.ReserveRates(ctx, limits)
+		if err != nil {
+			slog.WarnContext(ctx, fmt.Errorf("%s -> %w", operation, err).Error())
+
+			return nil
+		}
+
+		if wait == 0 {
+			return nil
+		}
+
+		if waited+wait > u.RateLimits.MaxWait {
+			return erring.NewSQSEventError(
+				int32(math.Ceil(wait.Seconds())),
+				fmt.Sprintf("%s: %s is rate limited for %s", operation, string(provider), wait),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s -> %w", operation, ctx.Err())
+		case <-time.After(wait):
+		}
+
+		waited += wait
+	}
+}
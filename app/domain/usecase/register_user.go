@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/library/timezone"
+)
+
+type Onboarding struct {
+	AutoRegister bool
+
+	// Name of the template sent on the first message of a user, which then
+	// stands in for the start of the flow. Skipped when empty.
+	WelcomeTemplate string
+}
+
+// registerUser creates a user for an unknown sender.
+func (u *UseCase) registerUser(ctx context.Context, input ProcessTwilioWebhookInput) (entity.User, error) {
+	const operation = "UseCase.registerUser"
+
+	name := input.ProfileName
+	if name == "" {
+		name = input.PhoneNumber
+	}
+
+	timeZone, _ := timezone.FromPhoneNumber(input.PhoneNumber)
+
+	user, err := u.UsersRepository.Create(ctx, entity.User{
+		Name:        name,
+		PhoneNumber: input.PhoneNumber,
+		WaID:        input.WaID,
+		TimeZone:    timeZone,
+
+		PreferredChannel: string(input.Provider),
+	})
+	if err != nil {
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return user, nil
+}
+
+// welcomeUser queues the welcome through the outbound queue, so a failed send
+// is retried by the workers. The key lets the queue drop the welcome queued
+// again when the first message of the user is processed anew.
+func (u *UseCase) welcomeUser(ctx context.Context, user entity.User) error {
+	const operation = "UseCase.welcomeUser"
+
+	err := u.Enqueuer.Outbound(ctx, dto.OutboundMessage{
+		TenantID:     entity.TenantIDFromContext(ctx),
+		UserID:       user.ID,
+		TemplateName: u.Onboarding.WelcomeTemplate,
+		Variables:    map[string]string{"1": user.Name},
+		Key:          "welcome:" + user.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/cursor"
+)
+
+type SearchUserMessagesInput struct {
+	Query       string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Limit       int
+
+	// Cursor returned with the previous page, empty for the first one.
+	Cursor string
+}
+
+type SearchUserMessagesOutput struct {
+	Matches []entity.UserMessageMatch
+
+	// Empty on the last page.
+	NextCursor string
+}
+
+// SearchUserMessages finds the messages of every user matching a full-text
+// query, newest first.
+func (u *UseCase) SearchUserMessages(ctx context.Context, input SearchUserMessagesInput) (SearchUserMessagesOutput, error) {
+	const operation = "UseCase.SearchUserMessages"
+
+	search := dto.UserMessagesSearch{
+		Query:       input.Query,
+		CreatedFrom: input.CreatedFrom,
+		CreatedTo:   input.CreatedTo,
+		// One more than asked tells whether there is a next page.
+		Limit: input.Limit + 1,
+	}
+
+	if input.Cursor != "" {
+		values, err := cursor.Decode(input.Cursor, 2) //nolint:gomnd
+		if err != nil {
+			return SearchUserMessagesOutput{}, fmt.Errorf("%s -> %w: %w", operation, erring.ErrRequestInvalid, err)
+		}
+
+		createdAt, err := time.Parse(time.RFC3339Nano, values[0])
+		if err != nil {
+			return SearchUserMessagesOutput{}, fmt.Errorf("%s -> %w: %w", operation, erring.ErrRequestInvalid, cursor.ErrInvalid)
+		}
+
+		search.BeforeCreatedAt = createdAt
+		search.BeforeID = values[1]
+	}
+
+	matches, err := u.UserMessagesRepository.Search(ctx, search)
+	if err != nil {
+		return SearchUserMessagesOutput{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	output := SearchUserMessagesOutput{Matches: matches}
+
+	if len(matches) > input.Limit {
+		output.Matches = matches[:input.Limit]
+		last := output.Matches[input.Limit-1].Message
+		output.NextCursor = cursor.Encode(last.CreatedAt.Format(time.RFC3339Nano), last.ID)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+const campaignBatchSize = 100
+
+// SendCampaigns runs the due campaigns, queueing one outbound message per
+// recipient for the workers to send. Progress is saved after every batch and
+// the status is checked before the next one, so paused or canceled campaigns
+// stop and interrupted ones resume after the last user handled. Once every
+// recipient is queued, the campaign completes only when no delivery is left
+// unsettled; until then each run queues again the deliveries stuck for longer
+// than the claim timeout. A campaign that fails is logged and picked up again
+// on the next run, without holding back the others.
+func (u *UseCase) SendCampaigns(ctx context.Context) error {
+	const operation = "UseCase.SendCampaigns"
+
+	campaigns, err := u.CampaignsRepository.ListDue(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	for _, campaign := range campaigns {
+		campaignCtx := ctxkey.PutTenantID(ctx, campaign.TenantID)
+
+		err = u.sendCampaign(campaignCtx, campaign)
+		if err != nil {
+			slog.ErrorContext(
+				campaignCtx,
+				fmt.Errorf("%s -> %w", operation, err).Error(),
+				slog.String("campaign_id", campaign.ID),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (u *UseCase) sendCampaign(ctx context.Context, campaign entity.Campaign) error {
+	const operation = "UseCase.sendCampaign"
+
+	if campaign.Status == types.CampaignScheduled {
+		running, err := campaign.Transition(types.CampaignRunning, time.Now())
+		if err != nil {
+			return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+		}
+
+		campaign, err = u.CampaignsRepository.UpdateStatus(ctx, running, types.CampaignScheduled)
+		if err != nil {
+			return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+		}
+	}
+
+	// Users created after the campaign started are left out, so the target
+	// counted on the first run holds for the runs that resume it.
+	if campaign.StartedAt != nil && (campaign.Audience.CreatedBefore == nil || campaign.StartedAt.Before(*campaign.Audience.CreatedBefore)) {
+		campaign.Audience.CreatedBefore = campaign.StartedAt
+	}
+
+	if campaign.Progress.CursorUserID == "" {
+		target, err := u.UsersRepository.CountAudience(ctx, campaign.Audience)
+		if err != nil {
+			return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+		}
+
+		campaign.Progress.Target = target
+	}
+
+	for {
+		users, err := u.UsersRepository.ListAudience(ctx, campaign.Audience, campaign.Progress.CursorUserID, campaignBatchSize)
+		if err != nil {
+			return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+		}
+
+		if len(users) == 0 {
+			break
+		}
+
+		now := time.Now()
+
+		for _, user := range users {
+			err = u.queueCampaignMessage(ctx, campaign, user, now, &campaign.Progress)
+			if err != nil {
+				return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+			}
+
+			campaign.Progress.CursorUserID = user.ID
+		}
+
+		err = u.CampaignsRepository.UpdateProgress(ctx, campaign.ID, campaign.Progress)
+		if err != nil {
+			return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+		}
+
+		current, err := u.CampaignsRepository.GetByID(ctx, campaign.ID)
+		if err != nil {
+			return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+		}
+
+		if current.Status != types.CampaignRunning {
+			return nil
+		}
+	}
+
+	settled, err := u.settleCampaign(ctx, campaign)
+	if err != nil {
+		return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+	}
+
+	if !settled {
+		return nil
+	}
+
+	completed, err := campaign.Transition(types.CampaignCompleted, time.Now())
+	if err != nil {
+		return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+	}
+
+	completed, err = u.CampaignsRepository.UpdateStatus(ctx, completed, types.CampaignRunning)
+	if err != nil {
+		return fmt.Errorf("%s (%s) -> %w", operation, campaign.ID, err)
+	}
+
+	slog.InfoContext(
+		ctx,
+		"campaign run completed",
+		slog.String("campaign_id", completed.ID),
+		slog.Int("target", completed.Progress.Target),
+		slog.Int("queued", completed.Progress.Queued),
+		slog.Int("deferred", completed.Progress.Deferred),
+		slog.Int("sent", completed.Progress.Sent),
+		slog.Int("skipped", completed.Progress.Skipped),
+		slog.Int("failed", completed.Progress.Failed),
+	)
+
+	return nil
+}
+
+// settleCampaign queues again the deliveries claimed for longer than the claim
+// timeout, whose workers stopped, and those never claimed within the queued
+// timeout, whose messages were lost, and tells whether every delivery reached
+// a final status. Deferred deliveries
+// are settled by the scheduled message that holds them.
+func (u *UseCase) settleCampaign(ctx context.Context, campaign entity.Campaign) (bool, error) {
+	const operation = "UseCase.settleCampaign"
+
+	now := time.Now()
+
+	userIDs, err := u.CampaignDeliveriesRepository.ReleaseStale(ctx, campaign.ID, now.Add(-u.OutboundClaimTimeout), now.Add(-u.OutboundQueuedTimeout))
+	if err != nil {
+		return false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	for _, userID := range userIDs {
+		user, err := u.UsersRepository.GetByID(ctx, userID)
+		if err != nil {
+			if errors.Is(err, erring.ErrUserNotFound) {
+				err = u.skipCampaignDelivery(ctx, campaign.ID, userID)
+				if err != nil {
+					return false, fmt.Errorf("%s -> %w", operation, err)
+				}
+
+				continue
+			}
+
+			return false, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		err = u.Enqueuer.Outbound(ctx, dto.OutboundMessage{
+			TenantID:     campaign.TenantID,
+			CampaignID:   campaign.ID,
+			UserID:       user.ID,
+			TemplateName: campaign.TemplateName,
+			Locale:       campaign.Locale,
+			Variables:    campaign.ResolveVariables(user),
+		})
+		if err != nil {
+			return false, fmt.Errorf("%s -> %w", operation, err)
+		}
+	}
+
+	unsettled, err := u.CampaignDeliveriesRepository.CountUnsettled(ctx, campaign.ID)
+	if err != nil {
+		return false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return unsettled == 0, nil
+}
+
+// skipCampaignDelivery settles the delivery of a user who no longer exists.
+func (u *UseCase) skipCampaignDelivery(ctx context.Context, campaignID, userID string) error {
+	claimed, err := u.CampaignDeliveriesRepository.Claim(ctx, campaignID, userID, time.Now())
+	if err != nil || !claimed {
+		return err
+	}
+
+	return u.CampaignDeliveriesRepository.Complete(ctx, campaignID, userID, types.CampaignDeliverySkipped)
+}
+
+// queueCampaignMessage records the delivery in the ledger and hands the message
+// to the outbound queue or, during the user's quiet hours, defers it. Users
+// already in the ledger are skipped, except for queued deliveries, which are
+// queued again since the run may have stopped before queueing them; the
+// worker claim keeps them from being sent twice.
+func (u *UseCase) queueCampaignMessage(ctx context.Context, campaign entity.Campaign, user entity.User, now time.Time, progress *entity.CampaignProgress) error {
+	const operation = "UseCase.queueCampaignMessage"
+
+	variables := campaign.ResolveVariables(user)
+
+	sendAt, deferred := u.QuietHours.deferUntil(now, user)
+
+	status := types.CampaignDeliveryQueued
+	if deferred {
+		status = types.CampaignDeliveryDeferred
+	}
+
+	delivery, created, err := u.CampaignDeliveriesRepository.Create(ctx, entity.CampaignDelivery{
+		CampaignID: campaign.ID,
+		UserID:     user.ID,
+		Status:     status,
+	})
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if !created && delivery.Status != types.CampaignDeliveryQueued {
+		return nil
+	}
+
+	if delivery.Status == types.CampaignDeliveryDeferred {
+		err := u.ScheduledMessagesRepository.Create(ctx, entity.ScheduledMessage{
+			UserID:           user.ID,
+			TemplateName:     campaign.TemplateName,
+			Locale:           campaign.Locale,
+			ContentVariables: variables,
+			SendAt:           sendAt,
+			CampaignID:       campaign.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		progress.Deferred++
+
+		return nil
+	}
+
+	err = u.Enqueuer.Outbound(ctx, dto.OutboundMessage{
+		TenantID:     campaign.TenantID,
+		CampaignID:   campaign.ID,
+		UserID:       user.ID,
+		TemplateName: campaign.TemplateName,
+		Locale:       campaign.Locale,
+		Variables:    variables,
+	})
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if created {
+		progress.Queued++
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+type SendOutboundMessageInput struct {
+	TenantID     string            `json:"tenant_id"`
+	CampaignID   string            `json:"campaign_id"`
+	UserID       string            `json:"user_id"`
+	TemplateName string            `json:"template_name"`
+	Locale       string            `json:"locale"`
+	Variables    map[string]string `json:"variables"`
+
+	Message       string `json:"message"`
+	Transactional bool   `json:"transactional"`
+
+	// Delivery number of the queue message, starting at 1.
+	Attempt int `json:"-"`
+}
+
+// SendOutboundMessage sends a queued campaign message once. The delivery is
+// claimed in the ledger first, so redeliveries of a message already handled
+// are dropped. A claim older than the claim timeout is taken over, and the
+// message recorded by the send tells whether it already left. Errors release
+// the claim and are returned so the message is retried, until the last
+// attempt, when it is recorded as failed instead.
+// Messages of canceled campaigns are skipped, and messages without a
+// campaign are sent without the ledger.
+func (u *UseCase) SendOutboundMessage(ctx context.Context, input SendOutboundMessageInput) error {
+	const operation = "UseCase.SendOutboundMessage"
+
+	if input.CampaignID == "" {
+		return u.sendQueuedMessage(ctx, input)
+	}
+
+	claimed, err := u.CampaignDeliveriesRepository.Claim(ctx, input.CampaignID, input.UserID, time.Now().Add(-u.OutboundClaimTimeout))
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if !claimed {
+		return nil
+	}
+
+	sent, err := u.UserMessagesRepository.ExistsByCampaign(ctx, input.CampaignID, input.UserID)
+	if err != nil {
+		if releaseErr := u.CampaignDeliveriesRepository.Release(ctx, input.CampaignID, input.UserID); releaseErr != nil {
+			err = errors.Join(err, releaseErr)
+		}
+
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	status := types.CampaignDeliverySent
+	if !sent {
+		status, err = u.sendOutboundMessage(ctx, input)
+	}
+
+	if err != nil {
+		// Being rate limited is not a failed attempt.
+		var sqsEventErr *erring.SQSEventError
+		if errors.As(err, &sqsEventErr) || input.Attempt < u.OutboundMaxAttempts {
+			if releaseErr := u.CampaignDeliveriesRepository.Release(ctx, input.CampaignID, input.UserID); releaseErr != nil {
+				err = errors.Join(err, releaseErr)
+			}
+
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		slog.ErrorContext(
+			ctx,
+			fmt.Errorf("%s -> giving up: %w", operation, err).Error(),
+			slog.String("campaign_id", input.CampaignID),
+			slog.String("user_id", input.UserID),
+			slog.Int("attempt", input.Attempt),
+		)
+
+		status = types.CampaignDeliveryFailed
+	}
+
+	err = u.CampaignDeliveriesRepository.Complete(ctx, input.CampaignID, input.UserID, status)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
+
+func (u *UseCase) sendOutboundMessage(ctx context.Context, input SendOutboundMessageInput) (types.CampaignDeliveryStatus, error) {
+	const operation = "UseCase.sendOutboundMessage"
+
+	campaign, err := u.CampaignsRepository.GetByID(ctx, input.CampaignID)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if campaign.Status == types.CampaignCanceled {
+		return types.CampaignDeliverySkipped, nil
+	}
+
+	user, err := u.UsersRepository.GetByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, erring.ErrUserNotFound) {
+			return types.CampaignDeliverySkipped, nil
+		}
+
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	err = u.sendUserMessageTemplate(ctx, user, dto.SendMessageTemplateInput{
+		Provider:          userProvider(user),
+		DestinationNumber: user.PhoneNumber,
+		TemplateName:      input.TemplateName,
+		Locale:            input.Locale,
+		Variables:         input.Variables,
+		CampaignID:        input.CampaignID,
+	})
+
+	switch {
+	case err == nil:
+		return types.CampaignDeliverySent, nil
+	case errors.Is(err, erring.ErrUserOptedOut):
+		return types.CampaignDeliverySkipped, nil
+	case errors.Is(err, erring.ErrTemplateNotFound), errors.Is(err, erring.ErrTemplateVariablesInvalid):
+		// Retrying cannot fix these.
+		slog.ErrorContext(
+			ctx,
+			fmt.Errorf("%s -> %w", operation, err).Error(),
+			slog.String("campaign_id", input.CampaignID),
+			slog.String("user_id", input.UserID),
+		)
+
+		return types.CampaignDeliveryFailed, nil
+	default:
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+}
+
+// sendQueuedMessage sends a message queued without a campaign, such as the
+// welcome of new users. There is no ledger to claim, so errors are returned
+// for the message to be retried until the last attempt, when it is dropped.
+func (u *UseCase) sendQueuedMessage(ctx context.Context, input SendOutboundMessageInput) error {
+	const operation = "UseCase.sendQueuedMessage"
+
+	user, err := u.UsersRepository.GetByID(ctx, input.UserID)
+
+	switch {
+	case err != nil:
+	case input.Message != "":
+		err = u.sendUserMessage(ctx, user, dto.SendMessageInput{
+			Provider:          userProvider(user),
+			DestinationNumber: user.PhoneNumber,
+			Message:           input.Message,
+			Transactional:     input.Transactional,
+		})
+	default:
+		err = u.sendUserMessageTemplate(ctx, user, dto.SendMessageTemplateInput{
+			Provider:          userProvider(user),
+			DestinationNumber: user.PhoneNumber,
+			TemplateName:      input.TemplateName,
+			Locale:            input.Locale,
+			Variables:         input.Variables,
+			Transactional:     input.Transactional,
+		})
+	}
+
+	var sqsEventErr *erring.SQSEventError
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, erring.ErrUserNotFound), errors.Is(err, erring.ErrUserOptedOut):
+		return nil
+	case errors.As(err, &sqsEventErr), input.Attempt < u.OutboundMaxAttempts:
+		return fmt.Errorf("%s -> %w", operation, err)
+	default:
+		slog.ErrorContext(
+			ctx,
+			fmt.Errorf("%s -> giving up: %w", operation, err).Error(),
+			slog.String("user_id", input.UserID),
+			slog.Int("attempt", input.Attempt),
+		)
+
+		return nil
+	}
+}
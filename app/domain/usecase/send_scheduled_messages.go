@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+// SendScheduledMessages sends the messages deferred by the quiet hours that
+// are now due. Rate limited messages stay pending until the limit lets them
+// through.
+func (u *UseCase) SendScheduledMessages(ctx context.Context) error {
+	const operation = "UseCase.SendScheduledMessages"
+
+	messages, err := u.ScheduledMessagesRepository.ListDue(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	for _, message := range messages {
+		status, retryAfter := u.sendScheduledMessage(ctxkey.PutTenantID(ctx, message.TenantID), message)
+
+		if status == types.ScheduledMessagePending {
+			err = u.ScheduledMessagesRepository.Reschedule(ctx, message.ID, time.Now().Add(retryAfter))
+		} else {
+			err = u.ScheduledMessagesRepository.UpdateStatus(ctx, message.ID, status)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+	}
+
+	return nil
+}
+
+// sendScheduledMessage returns the status the message ends up with and, when
+// rate limited, how long it stays pending. Messages deferred by a campaign
+// are canceled with the campaign, and not sent again when the campaign
+// message already left on an earlier run.
+func (u *UseCase) sendScheduledMessage(ctx context.Context, message entity.ScheduledMessage) (types.ScheduledMessageStatus, time.Duration) {
+	const operation = "UseCase.sendScheduledMessage"
+
+	if message.CampaignID != "" {
+		status, err := u.scheduledCampaignStatus(ctx, message)
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				fmt.Errorf("%s -> %w", operation, err).Error(),
+				slog.String("scheduled_message_id", message.ID),
+			)
+
+			return types.ScheduledMessageFailed, 0
+		}
+
+		if status != types.ScheduledMessagePending {
+			return status, 0
+		}
+	}
+
+	user, err := u.UsersRepository.GetByID(ctx, message.UserID)
+	if err == nil {
+		err = u.sendUserMessageTemplate(ctx, user, dto.SendMessageTemplateInput{
+			Provider:          userProvider(user),
+			DestinationNumber: user.PhoneNumber,
+			TemplateName:      message.TemplateName,
+			Locale:            message.Locale,
+			Variables:         message.ContentVariables,
+			CampaignID:        message.CampaignID,
+		})
+	}
+
+	var sqsEventErr *erring.SQSEventError
+
+	switch {
+	case err == nil:
+		return types.ScheduledMessageSent, 0
+	case errors.Is(err, erring.ErrUserOptedOut), errors.Is(err, erring.ErrUserNotFound):
+		return types.ScheduledMessageCanceled, 0
+	case errors.As(err, &sqsEventErr):
+		return types.ScheduledMessagePending, time.Duration(sqsEventErr.NewVisibilityTimeout) * time.Second
+	default:
+		slog.ErrorContext(
+			ctx,
+			fmt.Errorf("%s -> %w", operation, err).Error(),
+			slog.String("scheduled_message_id", message.ID),
+		)
+
+		return types.ScheduledMessageFailed, 0
+	}
+}
+
+// scheduledCampaignStatus settles a campaign message without sending it when
+// its campaign was canceled or the message was already sent, and otherwise
+// leaves it pending.
+func (u *UseCase) scheduledCampaignStatus(ctx context.Context, message entity.ScheduledMessage) (types.ScheduledMessageStatus, error) {
+	campaign, err := u.CampaignsRepository.GetByID(ctx, message.CampaignID)
+	if err != nil {
+		return "", err
+	}
+
+	if campaign.Status == types.CampaignCanceled {
+		return types.ScheduledMessageCanceled, nil
+	}
+
+	sent, err := u.UserMessagesRepository.ExistsByCampaign(ctx, message.CampaignID, message.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	if sent {
+		return types.ScheduledMessageSent, nil
+	}
+
+	return types.ScheduledMessagePending, nil
+}
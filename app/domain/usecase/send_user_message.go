@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
+	"github.com/chatbot-go/app/library/sms"
+)
+
+// sendUserMessage sends a free-form message to the user and records it in
+// the user transcript. Users who opted out only get transactional messages.
+func (u *UseCase) sendUserMessage(ctx context.Context, user entity.User, input dto.SendMessageInput) error {
+	const operation = "UseCase.sendUserMessage"
+
+	if user.Consent == types.ConsentOptedOut && !input.Transactional {
+		return fmt.Errorf("%s -> %w", operation, erring.ErrUserOptedOut)
+	}
+
+	if input.Provider == dto.SMSProvider && u.SMSMaxSegments > 0 {
+		if segments := sms.Segments(input.Message); segments > u.SMSMaxSegments {
+			return fmt.Errorf("%s (%d segments) -> %w", operation, segments, erring.ErrUserMessageTooLong)
+		}
+	}
+
+	messenger, err := u.messenger(input.Provider)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if err := u.waitRateLimit(ctx, input.Provider); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	sid, err := messenger.SendText(ctx, input)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	u.recordOutboundMessage(ctx, entity.UserMessage{
+		UserID:     user.ID,
+		Direction:  types.OutboundMessage,
+		Message:    input.Message,
+		TwilioSID:  sid,
+		Status:     types.MessageQueued,
+		CampaignID: input.CampaignID,
+	})
+
+	return nil
+}
+
+// sendUserMedia sends a media file, with an optional caption, to the user and
+// records it in the user transcript. Users who opted out only get
+// transactional messages.
+func (u *UseCase) sendUserMedia(ctx context.Context, user entity.User, input dto.SendMediaInput) error {
+	const operation = "UseCase.sendUserMedia"
+
+	if user.Consent == types.ConsentOptedOut && !input.Transactional {
+		return fmt.Errorf("%s -> %w", operation, erring.ErrUserOptedOut)
+	}
+
+	messenger, err := u.messenger(input.Provider)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if err := u.waitRateLimit(ctx, input.Provider); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	sid, err := messenger.SendMedia(ctx, input)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	u.recordOutboundMessage(ctx, entity.UserMessage{
+		UserID:    user.ID,
+		Direction: types.OutboundMessage,
+		Message:   input.Caption,
+		TwilioSID: sid,
+		Status:    types.MessageQueued,
+	})
+
+	return nil
+}
+
+// sendUserMessageTemplate resolves the template from the catalog, validates
+// its variables, sends it to the user and records it in the user transcript.
+// Users who opted out only get transactional messages. SMS has no content
+// sids, so the plain-text body of the template is sent instead.
+func (u *UseCase) sendUserMessageTemplate(ctx context.Context, user entity.User, input dto.SendMessageTemplateInput) error {
+	const operation = "UseCase.sendUserMessageTemplate"
+
+	if user.Consent == types.ConsentOptedOut && !input.Transactional {
+		return fmt.Errorf("%s -> %w", operation, erring.ErrUserOptedOut)
+	}
+
+	if input.Locale == "" {
+		input.Locale = u.DefaultLocale
+	}
+
+	template, err := u.getTemplate(ctx, input.TemplateName, input.Locale, input.Provider)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if err := template.ValidateVariables(input.Variables); err != nil {
+		return fmt.Errorf("%s (%s) -> %w: %w", operation, template.Name, erring.ErrTemplateVariablesInvalid, err)
+	}
+
+	if input.Provider == dto.SMSProvider {
+		if template.Body == "" {
+			return fmt.Errorf("%s (%s) -> %w", operation, template.Name, erring.ErrTemplateBodyMissing)
+		}
+
+		err = u.sendUserMessage(ctx, user, dto.SendMessageInput{
+			Provider:          input.Provider,
+			DestinationNumber: input.DestinationNumber,
+			Message:           template.Render(input.Variables),
+			Transactional:     input.Transactional,
+			CampaignID:        input.CampaignID,
+		})
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		return nil
+	}
+
+	input.ContentSID = template.ContentSID
+
+	messenger, err := u.messenger(input.Provider)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if err := u.waitRateLimit(ctx, input.Provider); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	sid, err := messenger.SendTemplate(ctx, input)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	u.recordOutboundMessage(ctx, entity.UserMessage{
+		UserID:           user.ID,
+		Direction:        types.OutboundMessage,
+		TwilioSID:        sid,
+		TemplateName:     input.TemplateName,
+		ContentVariables: input.Variables,
+		Status:           types.MessageQueued,
+		CampaignID:       input.CampaignID,
+	})
+
+	return nil
+}
+
+// getTemplate looks the template up for the channel. SMS falls back to the
+// WhatsApp template, whose body is sent as plain text.
+func (u *UseCase) getTemplate(ctx context.Context, name, locale string, provider dto.Provider) (entity.Template, error) {
+	const operation = "UseCase.getTemplate"
+
+	template, err := u.TemplatesRepository.Get(ctx, name, locale, string(provider))
+	if errors.Is(err, erring.ErrTemplateNotFound) && provider == dto.SMSProvider {
+		template, err = u.TemplatesRepository.Get(ctx, name, locale, string(dto.WhatsappProvider))
+	}
+
+	if err != nil {
+		return entity.Template{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return template, nil
+}
+
+// recordOutboundMessage does not fail the send: the message already left and
+// retrying would deliver it twice. Campaign messages are recorded with their
+// campaign, which tells a delivery taken over from a stopped worker that the
+// message already left.
+func (u *UseCase) recordOutboundMessage(ctx context.Context, message entity.UserMessage) {
+	const operation = "UseCase.recordOutboundMessage"
+
+	_, err := u.UserMessagesRepository.Create(ctx, message)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			fmt.Errorf("%s -> %w", operation, err).Error(),
+			slog.String("twilio_sid", message.TwilioSID),
+		)
+	}
+}
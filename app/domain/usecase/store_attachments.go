@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"mime"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// storeAttachments downloads the media sent with a message and keeps a copy in
+// the blob store.
+func (u *UseCase) storeAttachments(ctx context.Context, provider dto.Provider, message entity.UserMessage, media []dto.Media) error {
+	const operation = "UseCase.storeAttachments"
+
+	if len(media) == 0 {
+		return nil
+	}
+
+	messenger, err := u.messenger(provider)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	for i, item := range media {
+		attachment, err := u.storeAttachment(ctx, messenger, message, item, i)
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		err = u.UserMessageAttachmentsRepository.Create(ctx, attachment)
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+	}
+
+	return nil
+}
+
+func (u *UseCase) storeAttachment(
+	ctx context.Context,
+	messenger Messenger,
+	message entity.UserMessage,
+	media dto.Media,
+	index int,
+) (entity.UserMessageAttachment, error) {
+	const operation = "UseCase.storeAttachment"
+
+	body, contentType, err := messenger.DownloadMedia(ctx, media.URL)
+	if err != nil {
+		return entity.UserMessageAttachment{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer body.Close()
+
+	if contentType == "" {
+		contentType = media.ContentType
+	}
+
+	key := fmt.Sprintf("user-messages/%s/%d%s", message.ID, index, fileExtension(contentType))
+
+	size, err := u.BlobStore.Put(ctx, key, contentType, body)
+	if err != nil {
+		return entity.UserMessageAttachment{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return entity.UserMessageAttachment{
+		UserMessageID: message.ID,
+		ContentType:   contentType,
+		SourceURL:     media.URL,
+		StorageKey:    key,
+		Size:          size,
+	}, nil
+}
+
+func fileExtension(contentType string) string {
+	extensions, err := mime.ExtensionsByType(contentType)
+	if err != nil || len(extensions) == 0 {
+		return ""
+	}
+
+	return extensions[0]
+}
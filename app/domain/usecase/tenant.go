@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/phone"
+)
+
+func (u *UseCase) GetTenant(ctx context.Context, id string) (entity.Tenant, error) {
+	const operation = "UseCase.GetTenant"
+
+	tenant, err := u.TenantsRepository.GetByID(ctx, id)
+	if err != nil {
+		return entity.Tenant{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return tenant, nil
+}
+
+// GetTenantByNumber returns the tenant that owns one of our numbers, as
+// webhooks are routed by the number they were sent to. Numbers no tenant
+// claims belong to the default tenant, so deployments that never registered
+// their numbers keep working.
+func (u *UseCase) GetTenantByNumber(ctx context.Context, address string) (entity.Tenant, error) {
+	const operation = "UseCase.GetTenantByNumber"
+
+	_, number, err := phone.ParseAddress(address)
+	if err != nil {
+		return entity.Tenant{}, fmt.Errorf("%s (%s) -> %w: %w", operation, address, erring.ErrEventInvalid, err)
+	}
+
+	tenant, err := u.TenantsRepository.GetByNumber(ctx, number)
+	if errors.Is(err, erring.ErrTenantNotFound) {
+		tenant, err = u.TenantsRepository.GetByID(ctx, entity.DefaultTenantID)
+	}
+
+	if err != nil {
+		return entity.Tenant{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return tenant, nil
+}
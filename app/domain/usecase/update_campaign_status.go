@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// ScheduleCampaign schedules a draft, reschedules a scheduled campaign or
+// resumes a paused one at the given time.
+func (u *UseCase) ScheduleCampaign(ctx context.Context, id string, at time.Time) (entity.Campaign, error) {
+	const operation = "UseCase.ScheduleCampaign"
+
+	campaign, err := u.transitionCampaign(ctx, id, types.CampaignScheduled, func(campaign *entity.Campaign) {
+		campaign.ScheduledAt = &at
+	})
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return campaign, nil
+}
+
+// PauseCampaign stops a campaign before its next batch. It resumes from where
+// it stopped once scheduled again.
+func (u *UseCase) PauseCampaign(ctx context.Context, id string) (entity.Campaign, error) {
+	const operation = "UseCase.PauseCampaign"
+
+	campaign, err := u.transitionCampaign(ctx, id, types.CampaignPaused, nil)
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return campaign, nil
+}
+
+func (u *UseCase) CancelCampaign(ctx context.Context, id string) (entity.Campaign, error) {
+	const operation = "UseCase.CancelCampaign"
+
+	campaign, err := u.transitionCampaign(ctx, id, types.CampaignCanceled, nil)
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return campaign, nil
+}
+
+func (u *UseCase) transitionCampaign(ctx context.Context, id string, status types.CampaignStatus, update func(*entity.Campaign)) (entity.Campaign, error) {
+	const operation = "UseCase.transitionCampaign"
+
+	campaign, err := u.CampaignsRepository.GetByID(ctx, id)
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	from := campaign.Status
+
+	campaign, err = campaign.Transition(status, time.Now())
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if update != nil {
+		update(&campaign)
+	}
+
+	campaign, err = u.CampaignsRepository.UpdateStatus(ctx, campaign, from)
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return campaign, nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/phone"
+)
+
+// UpdateUserInput holds the fields to change, nil ones are kept.
+type UpdateUserInput struct {
+	Name        *string
+	PhoneNumber *string
+	TimeZone    *string
+	Channel     *string
+}
+
+func (u *UseCase) UpdateUser(ctx context.Context, id string, input UpdateUserInput) (entity.User, error) {
+	const operation = "UseCase.UpdateUser"
+
+	user, err := u.UsersRepository.GetByID(ctx, id)
+	if err != nil {
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if input.Name != nil {
+		user.Name = *input.Name
+	}
+
+	if input.PhoneNumber != nil {
+		phoneNumber, err := phone.Normalize(*input.PhoneNumber)
+		if err != nil {
+			return entity.User{}, fmt.Errorf("%s -> %w: %w", operation, erring.ErrRequestInvalid, err)
+		}
+
+		if phoneNumber != user.PhoneNumber {
+			if err := u.checkPhoneNumberAvailable(ctx, phoneNumber, user.ID); err != nil {
+				return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+			}
+
+			user.PhoneNumber = phoneNumber
+		}
+	}
+
+	if input.TimeZone != nil {
+		user.TimeZone = *input.TimeZone
+	}
+
+	if input.Channel != nil {
+		user.PreferredChannel = *input.Channel
+	}
+
+	user, err = u.UsersRepository.Update(ctx, user)
+	if err != nil {
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return user, nil
+}
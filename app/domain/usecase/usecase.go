@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/chatbot-go/app/domain/dto"
 	"github.com/chatbot-go/app/domain/entity"
@@ -11,20 +13,71 @@ import (
 type UseCase struct {
 	AppName string
 
+	// Conversation
+	Flows               map[string]entity.Flow
+	DefaultFlowID       string
+	ConversationTimeout time.Duration
+	Onboarding          Onboarding
+	Consent             Consent
+	QuietHours          QuietHours
+
+	// Templates
+	DefaultLocale string
+
 	// Messaging
-	Enqueuer enqueuer
+	Enqueuer              enqueuer
+	OutboundMaxAttempts   int
+	OutboundClaimTimeout  time.Duration
+	OutboundQueuedTimeout time.Duration
+	RateLimiter           rateLimiter
+	RateLimits            RateLimits
+
+	// Longer SMS messages are rejected, unlimited when zero.
+	SMSMaxSegments int
+
+	// Cache
+	Cache cache
 
-	// Clients
-	TwilioClient twilioClient
+	// Storage
+	BlobStore blobStore
+
+	// Clients, the messaging adapter serving each channel
+	Messengers map[dto.Provider]Messenger
 
 	// Repos
-	JobsControlRepository  jobsControlRepository
-	UsersRepository        usersRepository
-	UserMessagesRepository userMessagesRepository
+	TenantsRepository       tenantsRepository
+	JobsControlRepository   jobsControlRepository
+	UsersRepository         usersRepository
+	UserMessagesRepository  userMessagesRepository
+	ConversationsRepository conversationsRepository
+
+	UserMessageAttachmentsRepository userMessageAttachmentsRepository
+	ScheduledMessagesRepository      scheduledMessagesRepository
+	TemplatesRepository              templatesRepository
+	CampaignsRepository              campaignsRepository
+	CampaignDeliveriesRepository     campaignDeliveriesRepository
+	HandoffsRepository               handoffsRepository
+	ExportRunsRepository             exportRunsRepository
 }
 
 type enqueuer interface {
-	WebhooksTwilio(ctx context.Context, webhook dto.WebhookTwilio) error
+	WebhooksTwilio(ctx context.Context, webhook dto.InboundMessage) error
+	WebhooksTwilioStatus(ctx context.Context, webhook dto.MessageStatusUpdate) error
+	Outbound(ctx context.Context, message dto.OutboundMessage) error
+}
+
+type cache interface {
+	Get(ctx context.Context, key string, objByRef any) error
+	Set(ctx context.Context, key string, obj any, ttl time.Duration) error
+	Del(ctx context.Context, key string) (bool, error)
+}
+
+type rateLimiter interface {
+	ReserveRates(ctx context.Context, limits []dto.RateLimit) (time.Duration, error)
+}
+
+type blobStore interface {
+	Put(ctx context.Context, key, contentType string, body io.Reader) (int64, error)
 }
 
 type jobsControlRepository interface {
@@ -33,16 +86,94 @@ type jobsControlRepository interface {
 	GetByJob(ctx context.Context, job types.Job) (entity.JobControl, error)
 }
 
+type tenantsRepository interface {
+	GetByID(ctx context.Context, id string) (entity.Tenant, error)
+	GetByNumber(ctx context.Context, phoneNumber string) (entity.Tenant, error)
+}
+
 type usersRepository interface {
+	Create(ctx context.Context, user entity.User) (entity.User, error)
+	GetByID(ctx context.Context, id string) (entity.User, error)
 	GetByPhoneNumber(ctx context.Context, phoneNumber string) (entity.User, error)
-	List(ctx context.Context) ([]entity.User, error)
+	ListAudience(ctx context.Context, audience entity.CampaignAudience, afterID string, limit int) ([]entity.User, error)
+	CountAudience(ctx context.Context, audience entity.CampaignAudience) (int, error)
+	UpdateConsent(ctx context.Context, event entity.ConsentEvent) error
+	List(ctx context.Context, filter dto.UsersFilter) ([]entity.User, error)
+	Update(ctx context.Context, user entity.User) (entity.User, error)
+	Delete(ctx context.Context, id string) error
+	Import(ctx context.Context, users []entity.User, dryRun bool) (dto.UsersImportResult, error)
+	UpdatePreferredChannel(ctx context.Context, id, channel string) error
 }
 
 type userMessagesRepository interface {
-	Create(ctx context.Context, message entity.UserMessage) error
+	Create(ctx context.Context, message entity.UserMessage) (entity.UserMessage, error)
+	UpdateStatus(ctx context.Context, twilioSID string, status types.MessageStatus, errorCode string) error
+	ListByUserID(ctx context.Context, userID string, limit int) ([]entity.UserMessage, error)
+	List(ctx context.Context, filter dto.UserMessagesFilter) ([]entity.UserMessage, error)
+	Search(ctx context.Context, search dto.UserMessagesSearch) ([]entity.UserMessageMatch, error)
+	Export(ctx context.Context, filter dto.UserMessagesExport, fn func(message entity.UserMessage, user entity.User) error) error
+	ExistsByCampaign(ctx context.Context, campaignID, userID string) (bool, error)
+	GetByTwilioSID(ctx context.Context, twilioSID string) (entity.UserMessage, error)
+	ExistsInbound(ctx context.Context, userID, exceptTwilioSID string) (bool, error)
+	MarkProcessed(ctx context.Context, id string) error
+}
+
+type userMessageAttachmentsRepository interface {
+	Create(ctx context.Context, attachment entity.UserMessageAttachment) error
+}
+
+type conversationsRepository interface {
+	GetByUserID(ctx context.Context, userID string) (entity.Conversation, error)
+	Advance(ctx context.Context, conversation entity.Conversation, messageID, handoffReason string) error
+}
+
+type scheduledMessagesRepository interface {
+	Create(ctx context.Context, message entity.ScheduledMessage) error
+	ListDue(ctx context.Context, now time.Time) ([]entity.ScheduledMessage, error)
+	UpdateStatus(ctx context.Context, id string, status types.ScheduledMessageStatus) error
+	Reschedule(ctx context.Context, id string, sendAt time.Time) error
+}
+
+type templatesRepository interface {
+	Get(ctx context.Context, name, locale, channel string) (entity.Template, error)
+}
+
+type campaignsRepository interface {
+	Create(ctx context.Context, campaign entity.Campaign) (entity.Campaign, error)
+	GetByID(ctx context.Context, id string) (entity.Campaign, error)
+	ListDue(ctx context.Context, now time.Time) ([]entity.Campaign, error)
+	UpdateStatus(ctx context.Context, campaign entity.Campaign, from types.CampaignStatus) (entity.Campaign, error)
+	UpdateProgress(ctx context.Context, id string, progress entity.CampaignProgress) error
+}
+
+type campaignDeliveriesRepository interface {
+	Create(ctx context.Context, delivery entity.CampaignDelivery) (entity.CampaignDelivery, bool, error)
+	Claim(ctx context.Context, campaignID, userID string, staleBefore time.Time) (bool, error)
+	Release(ctx context.Context, campaignID, userID string) error
+	Complete(ctx context.Context, campaignID, userID string, status types.CampaignDeliveryStatus) error
+	ReleaseStale(ctx context.Context, campaignID string, claimedBefore, queuedBefore time.Time) ([]string, error)
+	CountUnsettled(ctx context.Context, campaignID string) (int, error)
+}
+
+type handoffsRepository interface {
+	GetByID(ctx context.Context, id string) (entity.Handoff, error)
+	GetActiveByUserID(ctx context.Context, userID string) (entity.Handoff, error)
+	List(ctx context.Context, status types.HandoffStatus) ([]entity.Handoff, error)
+	Claim(ctx context.Context, id, agentID string) error
+	Close(ctx context.Context, id, agentID string) error
+	CreateEvent(ctx context.Context, event entity.HandoffEvent) error
+}
+
+type exportRunsRepository interface {
+	Create(ctx context.Context, run entity.ExportRun) (entity.ExportRun, error)
+	Finish(ctx context.Context, run entity.ExportRun) (entity.ExportRun, error)
 }
 
-type twilioClient interface {
-	SendMessage(ctx context.Context, input dto.SendMessageInput) error
-	SendMessageTemplate(ctx context.Context, input dto.SendMessageTemplateInput) error
+// Messenger is the port to a messaging provider. The ids returned by the
+// senders are the ones the provider status updates refer to.
+type Messenger interface {
+	SendText(ctx context.Context, input dto.SendMessageInput) (string, error)
+	SendTemplate(ctx context.Context, input dto.SendMessageTemplateInput) (string, error)
+	SendMedia(ctx context.Context, input dto.SendMediaInput) (string, error)
+	DownloadMedia(ctx context.Context, url string) (io.ReadCloser, string, error)
 }
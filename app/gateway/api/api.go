@@ -10,15 +10,17 @@ import (
 	"github.com/chatbot-go/app/gateway/api/handler"
 	"github.com/chatbot-go/app/gateway/api/middleware"
 	"github.com/chatbot-go/app/gateway/client/twilio"
+	"github.com/chatbot-go/app/gateway/client/whatsappcloud"
 	"github.com/chatbot-go/app/gateway/redis"
 )
 
 type API struct {
-	Handler      http.Handler
-	cfg          config.Config
-	useCase      *usecase.UseCase
-	redisClient  *redis.Client
-	twilioClient *twilio.Client
+	Handler       http.Handler
+	cfg           config.Config
+	useCase       *usecase.UseCase
+	redisClient   *redis.Client
+	twilioTenants *twilio.Tenants
+	cloudClient   *whatsappcloud.Client
 }
 
 func BasicHandler() http.Handler {
@@ -28,12 +30,13 @@ func BasicHandler() http.Handler {
 	return router
 }
 
-func New(cfg config.Config, redisClient *redis.Client, useCase *usecase.UseCase, twilioClient *twilio.Client) *API {
+func New(cfg config.Config, redisClient *redis.Client, useCase *usecase.UseCase, twilioTenants *twilio.Tenants, cloudClient *whatsappcloud.Client) *API {
 	api := &API{
-		cfg:          cfg,
-		useCase:      useCase,
-		redisClient:  redisClient,
-		twilioClient: twilioClient,
+		cfg:           cfg,
+		useCase:       useCase,
+		redisClient:   redisClient,
+		twilioTenants: twilioTenants,
+		cloudClient:   cloudClient,
 	}
 
 	api.setupRouter()
@@ -70,7 +73,22 @@ func (api *API) registerRoutes(router *chi.Mux) {
 			api.cfg,
 			api.useCase,
 			api.redisClient,
-			api.twilioClient,
+			api.twilioTenants,
+			api.cloudClient,
+		)
+
+		handler.RegisterAdminRoutes(
+			publicRouter.With(middleware.AdminAuth(api.cfg.Admin.APIToken, api.cfg.Admin.TenantAPITokens), middleware.TenantFromHeader(api.useCase)),
+			api.cfg,
+			api.useCase,
+			api.redisClient,
+		)
+
+		handler.RegisterAgentRoutes(
+			publicRouter.With(middleware.AgentAuth(api.cfg.Admin.AgentTokens, api.cfg.Admin.AgentTenants), middleware.TenantFromHeader(api.useCase)),
+			api.cfg,
+			api.useCase,
+			api.redisClient,
 		)
 	})
 }
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+type handoffResponse struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	UserName    string     `json:"user_name"`
+	PhoneNumber string     `json:"phone_number"`
+	Status      string     `json:"status"`
+	AgentID     string     `json:"agent_id,omitempty"`
+	Reason      string     `json:"reason,omitempty"`
+	OpenedAt    time.Time  `json:"opened_at"`
+	ClaimedAt   *time.Time `json:"claimed_at,omitempty"`
+	ClosedAt    *time.Time `json:"closed_at,omitempty"`
+}
+
+func newHandoffResponse(handoff entity.Handoff) handoffResponse {
+	return handoffResponse{
+		ID:          handoff.ID,
+		UserID:      handoff.User.ID,
+		UserName:    handoff.User.Name,
+		PhoneNumber: handoff.User.PhoneNumber,
+		Status:      string(handoff.Status),
+		AgentID:     handoff.AgentID,
+		Reason:      handoff.Reason,
+		OpenedAt:    handoff.OpenedAt,
+		ClaimedAt:   handoff.ClaimedAt,
+		ClosedAt:    handoff.ClosedAt,
+	}
+}
+
+type userMessageResponse struct {
+	ID           string    `json:"id"`
+	Direction    string    `json:"direction"`
+	Message      string    `json:"message,omitempty"`
+	TemplateName string    `json:"template_name,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func newUserMessageResponse(message entity.UserMessage) userMessageResponse {
+	return userMessageResponse{
+		ID:           message.ID,
+		Direction:    string(message.Direction),
+		Message:      message.Message,
+		TemplateName: message.TemplateName,
+		Status:       string(message.Status),
+		CreatedAt:    message.CreatedAt,
+	}
+}
+
+func agentID(ctx context.Context) string {
+	id, _ := ctxkey.GetAgentID(ctx)
+
+	return id
+}
+
+func handoffErrorResponse(err error) *response.Response {
+	switch {
+	case errors.Is(err, erring.ErrHandoffNotFound),
+		errors.Is(err, erring.ErrHandoffTransitionInvalid),
+		errors.Is(err, erring.ErrHandoffNotClaimedByAgent),
+		errors.Is(err, erring.ErrUserMessageTooLong),
+		errors.Is(err, erring.ErrUserOptedOut):
+		return response.AppExpectedError(err)
+	default:
+		return response.InternalServerError(err)
+	}
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	AgentHandoffsClaimCommand = "agent-handoffs-claim"
+	AgentHandoffsClaimPattern = "/agent/handoffs/{id}/claim"
+)
+
+func (h *Handler) AgentHandoffsClaimSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(AgentHandoffsClaimCommand)
+	handler := rest.HandleWithCircuit(circuit, AgentHandoffsClaimPattern, h.AgentHandoffsClaim)
+
+	router.Post(AgentHandoffsClaimPattern, handler)
+}
+
+func (h *Handler) AgentHandoffsClaim(req *http.Request) *response.Response {
+	handoff, err := h.useCase.ClaimHandoff(req.Context(), chi.URLParam(req, "id"), agentID(req.Context()))
+	if err != nil {
+		return handoffErrorResponse(err)
+	}
+
+	return response.OK(newHandoffResponse(handoff))
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	AgentHandoffsCloseCommand = "agent-handoffs-close"
+	AgentHandoffsClosePattern = "/agent/handoffs/{id}/close"
+)
+
+func (h *Handler) AgentHandoffsCloseSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(AgentHandoffsCloseCommand)
+	handler := rest.HandleWithCircuit(circuit, AgentHandoffsClosePattern, h.AgentHandoffsClose)
+
+	router.Post(AgentHandoffsClosePattern, handler)
+}
+
+func (h *Handler) AgentHandoffsClose(req *http.Request) *response.Response {
+	handoff, err := h.useCase.CloseHandoff(req.Context(), chi.URLParam(req, "id"), agentID(req.Context()))
+	if err != nil {
+		return handoffErrorResponse(err)
+	}
+
+	return response.OK(newHandoffResponse(handoff))
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/types"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	AgentHandoffsListCommand = "agent-handoffs-list"
+	AgentHandoffsListPattern = "/agent/handoffs"
+)
+
+func (h *Handler) AgentHandoffsListSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(AgentHandoffsListCommand)
+	handler := rest.HandleWithCircuit(circuit, AgentHandoffsListPattern, h.AgentHandoffsList)
+
+	router.Get(AgentHandoffsListPattern, handler)
+}
+
+// AgentHandoffsList lists the handoffs with the status query parameter,
+// open by default.
+func (h *Handler) AgentHandoffsList(req *http.Request) *response.Response {
+	status := types.HandoffStatus(req.URL.Query().Get("status"))
+	if status == "" {
+		status = types.HandoffOpen
+	}
+
+	err := validation.Validate(status, validation.In(types.HandoffOpen, types.HandoffClaimed, types.HandoffClosed))
+	if err != nil {
+		return response.BadRequest(err, "invalid status")
+	}
+
+	handoffs, err := h.useCase.ListHandoffs(req.Context(), status)
+	if err != nil {
+		return handoffErrorResponse(err)
+	}
+
+	payload := make([]handoffResponse, 0, len(handoffs))
+	for _, handoff := range handoffs {
+		payload = append(payload, newHandoffResponse(handoff))
+	}
+
+	return response.OK(payload)
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	AgentHandoffsMessagesCommand = "agent-handoffs-messages"
+	AgentHandoffsMessagesPattern = "/agent/handoffs/{id}/messages"
+)
+
+func (h *Handler) AgentHandoffsMessagesSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(AgentHandoffsMessagesCommand)
+	handler := rest.HandleWithCircuit(circuit, AgentHandoffsMessagesPattern, h.AgentHandoffsMessages)
+
+	router.Get(AgentHandoffsMessagesPattern, handler)
+}
+
+func (h *Handler) AgentHandoffsMessages(req *http.Request) *response.Response {
+	messages, err := h.useCase.ListHandoffMessages(req.Context(), chi.URLParam(req, "id"))
+	if err != nil {
+		return handoffErrorResponse(err)
+	}
+
+	payload := make([]userMessageResponse, 0, len(messages))
+	for _, message := range messages {
+		payload = append(payload, newUserMessageResponse(message))
+	}
+
+	return response.OK(payload)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	AgentHandoffsReplyCommand = "agent-handoffs-reply"
+	AgentHandoffsReplyPattern = "/agent/handoffs/{id}/reply"
+
+	// WhatsApp limit for a free-form message body.
+	maxReplyLength = 4096
+)
+
+type replyHandoffRequest struct {
+	Message string `json:"message"`
+}
+
+func (r replyHandoffRequest) Validate() error {
+	return validation.ValidateStruct(&r, //nolint:wrapcheck
+		validation.Field(&r.Message, validation.Required, validation.RuneLength(1, maxReplyLength)),
+	)
+}
+
+func (h *Handler) AgentHandoffsReplySetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(AgentHandoffsReplyCommand)
+	handler := rest.HandleWithCircuit(circuit, AgentHandoffsReplyPattern, h.AgentHandoffsReply)
+
+	router.Post(AgentHandoffsReplyPattern, handler)
+}
+
+func (h *Handler) AgentHandoffsReply(req *http.Request) *response.Response {
+	var body replyHandoffRequest
+
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return response.BadRequest(err, "invalid request body")
+	}
+
+	if err := body.Validate(); err != nil {
+		return response.BadRequest(err, "invalid reply")
+	}
+
+	err := h.useCase.ReplyHandoff(req.Context(), chi.URLParam(req, "id"), agentID(req.Context()), body.Message)
+	if err != nil {
+		return handoffErrorResponse(err)
+	}
+
+	return response.NoContent()
+}
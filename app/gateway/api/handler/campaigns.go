@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+type campaignAudience struct {
+	PhonePrefixes []string   `json:"phone_prefixes,omitempty"`
+	TimeZones     []string   `json:"time_zones,omitempty"`
+	CreatedAfter  *time.Time `json:"created_after,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+}
+
+func (a campaignAudience) Validate() error {
+	return validation.ValidateStruct(&a, //nolint:wrapcheck
+		validation.Field(&a.PhonePrefixes, validation.Each(validation.Required, validation.Match(phoneNumberPrefixRegex))),
+	)
+}
+
+type campaignProgress struct {
+	Target   int `json:"target"`
+	Queued   int `json:"queued"`
+	Sent     int `json:"sent"`
+	Deferred int `json:"deferred"`
+	Skipped  int `json:"skipped"`
+	Failed   int `json:"failed"`
+}
+
+type campaignResponse struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	TemplateName string            `json:"template_name"`
+	Locale       string            `json:"locale"`
+	Audience     campaignAudience  `json:"audience"`
+	Variables    map[string]string `json:"variables"`
+	Status       string            `json:"status"`
+	ScheduledAt  *time.Time        `json:"scheduled_at,omitempty"`
+	StartedAt    *time.Time        `json:"started_at,omitempty"`
+	CompletedAt  *time.Time        `json:"completed_at,omitempty"`
+	Progress     campaignProgress  `json:"progress"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+func newCampaignResponse(campaign entity.Campaign) campaignResponse {
+	return campaignResponse{
+		ID:           campaign.ID,
+		Name:         campaign.Name,
+		TemplateName: campaign.TemplateName,
+		Locale:       campaign.Locale,
+		Audience:     campaignAudience(campaign.Audience),
+		Variables:    campaign.Variables,
+		Status:       string(campaign.Status),
+		ScheduledAt:  campaign.ScheduledAt,
+		StartedAt:    campaign.StartedAt,
+		CompletedAt:  campaign.CompletedAt,
+		Progress: campaignProgress{
+			Target:   campaign.Progress.Target,
+			Queued:   campaign.Progress.Queued,
+			Sent:     campaign.Progress.Sent,
+			Deferred: campaign.Progress.Deferred,
+			Skipped:  campaign.Progress.Skipped,
+			Failed:   campaign.Progress.Failed,
+		},
+		CreatedAt: campaign.CreatedAt,
+		UpdatedAt: campaign.UpdatedAt,
+	}
+}
+
+type scheduleCampaignRequest struct {
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+func (r scheduleCampaignRequest) Validate() error {
+	return validation.ValidateStruct(&r, //nolint:wrapcheck
+		validation.Field(&r.ScheduledAt, validation.Required),
+	)
+}
+
+func campaignErrorResponse(err error) *response.Response {
+	switch {
+	case errors.Is(err, erring.ErrCampaignNotFound),
+		errors.Is(err, erring.ErrCampaignTransitionInvalid),
+		errors.Is(err, erring.ErrCampaignVariablesInvalid),
+		errors.Is(err, erring.ErrTemplateNotFound),
+		errors.Is(err, erring.ErrTemplateVariablesInvalid):
+		return response.AppExpectedError(err)
+	default:
+		return response.InternalServerError(err)
+	}
+}
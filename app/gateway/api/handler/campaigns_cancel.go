@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	CampaignsCancelCommand = "campaigns-cancel"
+	CampaignsCancelPattern = "/campaigns/{id}/cancel"
+)
+
+func (h *Handler) CampaignsCancelSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(CampaignsCancelCommand)
+	handler := rest.HandleWithCircuit(circuit, CampaignsCancelPattern, h.CampaignsCancel)
+
+	router.Post(CampaignsCancelPattern, handler)
+}
+
+func (h *Handler) CampaignsCancel(req *http.Request) *response.Response {
+	campaign, err := h.useCase.CancelCampaign(req.Context(), chi.URLParam(req, "id"))
+	if err != nil {
+		return campaignErrorResponse(err)
+	}
+
+	return response.OK(newCampaignResponse(campaign))
+}
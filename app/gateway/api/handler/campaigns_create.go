@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	CampaignsCreateCommand = "campaigns-create"
+	CampaignsCreatePattern = "/campaigns"
+)
+
+type createCampaignRequest struct {
+	Name         string            `json:"name"`
+	TemplateName string            `json:"template_name"`
+	Locale       string            `json:"locale"`
+	Audience     campaignAudience  `json:"audience"`
+	Variables    map[string]string `json:"variables"`
+	ScheduledAt  *time.Time        `json:"scheduled_at"`
+}
+
+func (r createCampaignRequest) Validate() error {
+	return validation.ValidateStruct(&r, //nolint:wrapcheck
+		validation.Field(&r.Name, validation.Required, validation.Length(1, 200)),
+		validation.Field(&r.TemplateName, validation.Required),
+		validation.Field(&r.Audience),
+		validation.Field(&r.Variables, validation.Each(validation.Required)),
+	)
+}
+
+func (h *Handler) CampaignsCreateSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(CampaignsCreateCommand)
+	handler := rest.HandleWithCircuit(circuit, CampaignsCreatePattern, h.CampaignsCreate)
+
+	router.Post(CampaignsCreatePattern, handler)
+}
+
+func (h *Handler) CampaignsCreate(req *http.Request) *response.Response {
+	var body createCampaignRequest
+
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return response.BadRequest(err, "invalid request body")
+	}
+
+	if err := body.Validate(); err != nil {
+		return response.BadRequest(err, "invalid campaign")
+	}
+
+	campaign, err := h.useCase.CreateCampaign(req.Context(), usecase.CreateCampaignInput{
+		Name:         body.Name,
+		TemplateName: body.TemplateName,
+		Locale:       body.Locale,
+		Audience:     entity.CampaignAudience(body.Audience),
+		Variables:    body.Variables,
+		ScheduledAt:  body.ScheduledAt,
+	})
+	if err != nil {
+		return campaignErrorResponse(err)
+	}
+
+	return response.Created(newCampaignResponse(campaign))
+}
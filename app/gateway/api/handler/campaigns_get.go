@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	CampaignsGetCommand = "campaigns-get"
+	CampaignsGetPattern = "/campaigns/{id}"
+)
+
+func (h *Handler) CampaignsGetSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(CampaignsGetCommand)
+	handler := rest.HandleWithCircuit(circuit, CampaignsGetPattern, h.CampaignsGet)
+
+	router.Get(CampaignsGetPattern, handler)
+}
+
+func (h *Handler) CampaignsGet(req *http.Request) *response.Response {
+	campaign, err := h.useCase.GetCampaign(req.Context(), chi.URLParam(req, "id"))
+	if err != nil {
+		return campaignErrorResponse(err)
+	}
+
+	return response.OK(newCampaignResponse(campaign))
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	CampaignsPauseCommand = "campaigns-pause"
+	CampaignsPausePattern = "/campaigns/{id}/pause"
+)
+
+func (h *Handler) CampaignsPauseSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(CampaignsPauseCommand)
+	handler := rest.HandleWithCircuit(circuit, CampaignsPausePattern, h.CampaignsPause)
+
+	router.Post(CampaignsPausePattern, handler)
+}
+
+func (h *Handler) CampaignsPause(req *http.Request) *response.Response {
+	campaign, err := h.useCase.PauseCampaign(req.Context(), chi.URLParam(req, "id"))
+	if err != nil {
+		return campaignErrorResponse(err)
+	}
+
+	return response.OK(newCampaignResponse(campaign))
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	CampaignsScheduleCommand = "campaigns-schedule"
+	CampaignsSchedulePattern = "/campaigns/{id}/schedule"
+)
+
+func (h *Handler) CampaignsScheduleSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(CampaignsScheduleCommand)
+	handler := rest.HandleWithCircuit(circuit, CampaignsSchedulePattern, h.CampaignsSchedule)
+
+	router.Post(CampaignsSchedulePattern, handler)
+}
+
+func (h *Handler) CampaignsSchedule(req *http.Request) *response.Response {
+	var body scheduleCampaignRequest
+
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return response.BadRequest(err, "invalid request body")
+	}
+
+	if err := body.Validate(); err != nil {
+		return response.BadRequest(err, "invalid schedule")
+	}
+
+	campaign, err := h.useCase.ScheduleCampaign(req.Context(), chi.URLParam(req, "id"), body.ScheduledAt)
+	if err != nil {
+		return campaignErrorResponse(err)
+	}
+
+	return response.OK(newCampaignResponse(campaign))
+}
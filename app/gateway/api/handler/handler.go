@@ -10,8 +10,12 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/types"
 	"github.com/chatbot-go/app/domain/usecase"
 	"github.com/chatbot-go/app/gateway/client/twilio"
+	"github.com/chatbot-go/app/gateway/client/whatsappcloud"
 )
 
 type Handler struct {
@@ -67,19 +71,93 @@ func RegisterPublicRoutes(
 	cfg config.Config,
 	useCase useCase,
 	cache cache,
-	twilioClient *twilio.Client,
+	twilioTenants *twilio.Tenants,
+	cloudClient *whatsappcloud.Client,
 ) {
 	handler := New(cfg, useCase, cache)
 
-	handler.WebhooksTwilioSetup(router, twilioClient)
+	handler.WebhooksTwilioSetup(router, twilioTenants)
+	handler.WebhooksTwilioStatusSetup(router, twilioTenants)
+	handler.WebhooksWhatsappCloudSetup(router, cloudClient)
+}
+
+// RegisterAdminRoutes registers the routes used by the back office. The
+// router is expected to be protected by the admin authentication.
+func RegisterAdminRoutes(
+	router chi.Router,
+	cfg config.Config,
+	useCase useCase,
+	cache cache,
+) {
+	handler := New(cfg, useCase, cache)
+
+	handler.CampaignsCreateSetup(router)
+	handler.CampaignsGetSetup(router)
+	handler.CampaignsScheduleSetup(router)
+	handler.CampaignsPauseSetup(router)
+	handler.CampaignsCancelSetup(router)
+
+	handler.UsersCreateSetup(router)
+	handler.UsersListSetup(router)
+	handler.UsersGetSetup(router)
+	handler.UsersUpdateSetup(router)
+	handler.UsersDeleteSetup(router)
+	handler.UsersMessagesSetup(router)
+
+	handler.MessagesSearchSetup(router)
+}
+
+// RegisterAgentRoutes registers the agent inbox routes. The router is
+// expected to be protected by the agent authentication.
+func RegisterAgentRoutes(
+	router chi.Router,
+	cfg config.Config,
+	useCase useCase,
+	cache cache,
+) {
+	handler := New(cfg, useCase, cache)
+
+	handler.AgentHandoffsListSetup(router)
+	handler.AgentHandoffsClaimSetup(router)
+	handler.AgentHandoffsMessagesSetup(router)
+	handler.AgentHandoffsReplySetup(router)
+	handler.AgentHandoffsCloseSetup(router)
 }
 
 type cache interface {
 	Exists(ctx context.Context, key string) (bool, error)
 	Get(ctx context.Context, key string, objByRef any) error
 	Set(ctx context.Context, key string, obj any, ttl time.Duration) error
+	SetNX(ctx context.Context, key string, obj any, ttl time.Duration) (bool, error)
+	Del(ctx context.Context, key string) (bool, error)
 }
 
 type useCase interface {
+	GetTenant(ctx context.Context, id string) (entity.Tenant, error)
+	GetTenantByNumber(ctx context.Context, address string) (entity.Tenant, error)
+
 	EnqueueTwilioWebhook(ctx context.Context, input usecase.EnqueueTwilioWebhookInput) error
+	EnqueueTwilioStatusWebhook(ctx context.Context, input usecase.EnqueueTwilioStatusWebhookInput) error
+	EnqueueInboundMessage(ctx context.Context, message dto.InboundMessage) error
+	EnqueueMessageStatus(ctx context.Context, update dto.MessageStatusUpdate) error
+
+	CreateCampaign(ctx context.Context, input usecase.CreateCampaignInput) (entity.Campaign, error)
+	GetCampaign(ctx context.Context, id string) (entity.Campaign, error)
+	ScheduleCampaign(ctx context.Context, id string, at time.Time) (entity.Campaign, error)
+	PauseCampaign(ctx context.Context, id string) (entity.Campaign, error)
+	CancelCampaign(ctx context.Context, id string) (entity.Campaign, error)
+
+	CreateUser(ctx context.Context, input usecase.CreateUserInput) (entity.User, error)
+	GetUser(ctx context.Context, id string) (entity.User, error)
+	UpdateUser(ctx context.Context, id string, input usecase.UpdateUserInput) (entity.User, error)
+	DeleteUser(ctx context.Context, id string) error
+	ListUsers(ctx context.Context, input usecase.ListUsersInput) (usecase.ListUsersOutput, error)
+	ListUserMessages(ctx context.Context, input usecase.ListUserMessagesInput) (usecase.ListUserMessagesOutput, error)
+	SearchUserMessages(ctx context.Context, input usecase.SearchUserMessagesInput) (usecase.SearchUserMessagesOutput, error)
+
+	ListHandoffs(ctx context.Context, status types.HandoffStatus) ([]entity.Handoff, error)
+	ClaimHandoff(ctx context.Context, id, agentID string) (entity.Handoff, error)
+	ListHandoffMessages(ctx context.Context, id string) ([]entity.UserMessage, error)
+	ReplyHandoff(ctx context.Context, id, agentID, message string) error
+	CloseHandoff(ctx context.Context, id, agentID string) (entity.Handoff, error)
 }
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	MessagesSearchCommand = "messages-search"
+	MessagesSearchPattern = "/messages/search"
+
+	messagesSearchDefaultLimit = 20
+	messagesSearchMaxLimit     = 100
+)
+
+type searchMessagesRequest struct {
+	Query       string
+	CreatedFrom string
+	CreatedTo   string
+	Limit       string
+	Cursor      string
+}
+
+func (r searchMessagesRequest) Validate() error {
+	return validation.ValidateStruct(&r, //nolint:wrapcheck
+		validation.Field(&r.Query, validation.Required, validation.Length(1, 200)),
+		validation.Field(&r.CreatedFrom, validation.Date(time.RFC3339)),
+		validation.Field(&r.CreatedTo, validation.Date(time.RFC3339)),
+		validation.Field(&r.Limit, validation.By(func(value any) error {
+			return validation.Validate(atoiOrZero(value.(string)), validation.Min(1), validation.Max(messagesSearchMaxLimit))
+		})),
+	)
+}
+
+type messageMatchResponse struct {
+	userMessageResponse
+
+	UserID      string `json:"user_id"`
+	UserName    string `json:"user_name"`
+	PhoneNumber string `json:"phone_number"`
+	Headline    string `json:"headline"`
+}
+
+func newMessageMatchResponse(match entity.UserMessageMatch) messageMatchResponse {
+	return messageMatchResponse{
+		userMessageResponse: newUserMessageResponse(match.Message),
+		UserID:              match.User.ID,
+		UserName:            match.User.Name,
+		PhoneNumber:         match.User.PhoneNumber,
+		Headline:            match.Headline,
+	}
+}
+
+type messageMatchesPageResponse struct {
+	Matches    []messageMatchResponse `json:"matches"`
+	NextCursor string                 `json:"next_cursor,omitempty"`
+}
+
+func (h *Handler) MessagesSearchSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(MessagesSearchCommand)
+	handler := rest.HandleWithCircuit(circuit, MessagesSearchPattern, h.MessagesSearch)
+
+	router.Get(MessagesSearchPattern, handler)
+}
+
+// MessagesSearch runs a full-text search over the messages of every user.
+// The q query parameter accepts quoted phrases, OR and -excluded words, and
+// the matches are highlighted with <mark> tags in the headline, whose text is
+// otherwise HTML-escaped.
+func (h *Handler) MessagesSearch(req *http.Request) *response.Response {
+	query := req.URL.Query()
+
+	params := searchMessagesRequest{
+		Query:       query.Get("q"),
+		CreatedFrom: query.Get("created_from"),
+		CreatedTo:   query.Get("created_to"),
+		Limit:       query.Get("limit"),
+		Cursor:      query.Get("cursor"),
+	}
+
+	if params.Limit == "" {
+		params.Limit = strconv.Itoa(messagesSearchDefaultLimit)
+	}
+
+	if err := params.Validate(); err != nil {
+		return response.BadRequest(err, "invalid query parameters")
+	}
+
+	page, err := h.useCase.SearchUserMessages(req.Context(), usecase.SearchUserMessagesInput{
+		Query:       params.Query,
+		CreatedFrom: parseOptionalTime(params.CreatedFrom),
+		CreatedTo:   parseOptionalTime(params.CreatedTo),
+		Limit:       atoiOrZero(params.Limit),
+		Cursor:      params.Cursor,
+	})
+	if err != nil {
+		return userErrorResponse(err)
+	}
+
+	payload := messageMatchesPageResponse{
+		Matches:    make([]messageMatchResponse, 0, len(page.Matches)),
+		NextCursor: page.NextCursor,
+	}
+
+	for _, match := range page.Matches {
+		payload.Matches = append(payload.Matches, newMessageMatchResponse(match))
+	}
+
+	return response.OK(payload)
+}
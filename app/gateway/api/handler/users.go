@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+var (
+	phoneNumberRegex       = regexp.MustCompile(`^\+?[1-9]\d{7,14}$`)
+	phoneNumberPrefixRegex = regexp.MustCompile(`^\+?\d{1,15}$`)
+)
+
+type userResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	PhoneNumber string    `json:"phone_number"`
+	WaID        string    `json:"wa_id,omitempty"`
+	Consent     string    `json:"consent"`
+	TimeZone    string    `json:"time_zone,omitempty"`
+	Channel     string    `json:"preferred_channel"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func newUserResponse(user entity.User) userResponse {
+	return userResponse{
+		ID:          user.ID,
+		Name:        user.Name,
+		PhoneNumber: user.PhoneNumber,
+		WaID:        user.WaID,
+		Consent:     string(user.Consent),
+		TimeZone:    user.TimeZone,
+		Channel:     user.PreferredChannel,
+		CreatedAt:   user.CreatedAt,
+	}
+}
+
+type usersPageResponse struct {
+	Users      []userResponse `json:"users"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+var validChannel = validation.In(string(dto.WhatsappProvider), string(dto.SMSProvider))
+
+// validTimeZone checks the value is an IANA time zone name.
+var validTimeZone = validation.By(func(value any) error {
+	var name string
+
+	switch v := value.(type) {
+	case string:
+		name = v
+	case *string:
+		if v == nil {
+			return nil
+		}
+
+		name = *v
+	}
+
+	if name == "" {
+		return nil
+	}
+
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("must be a valid time zone: %w", err)
+	}
+
+	return nil
+})
+
+func userErrorResponse(err error) *response.Response {
+	switch {
+	case errors.Is(err, erring.ErrUserNotFound),
+		errors.Is(err, erring.ErrUserAlreadyExists),
+		errors.Is(err, erring.ErrRequestInvalid):
+		return response.AppExpectedError(err)
+	default:
+		return response.InternalServerError(err)
+	}
+}
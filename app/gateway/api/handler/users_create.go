@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	UsersCreateCommand = "users-create"
+	UsersCreatePattern = "/users"
+)
+
+type createUserRequest struct {
+	Name        string `json:"name"`
+	PhoneNumber string `json:"phone_number"`
+	TimeZone    string `json:"time_zone"`
+	Channel     string `json:"preferred_channel"`
+}
+
+func (r createUserRequest) Validate() error {
+	return validation.ValidateStruct(&r, //nolint:wrapcheck
+		validation.Field(&r.Name, validation.Required, validation.Length(1, 200)),
+		validation.Field(&r.PhoneNumber, validation.Required, validation.Match(phoneNumberRegex)),
+		validation.Field(&r.TimeZone, validTimeZone),
+		validation.Field(&r.Channel, validChannel),
+	)
+}
+
+func (h *Handler) UsersCreateSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(UsersCreateCommand)
+	handler := rest.HandleWithCircuit(circuit, UsersCreatePattern, h.UsersCreate)
+
+	router.Post(UsersCreatePattern, handler)
+}
+
+func (h *Handler) UsersCreate(req *http.Request) *response.Response {
+	var body createUserRequest
+
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return response.BadRequest(err, "invalid request body")
+	}
+
+	if err := body.Validate(); err != nil {
+		return response.BadRequest(err, "invalid user")
+	}
+
+	user, err := h.useCase.CreateUser(req.Context(), usecase.CreateUserInput{
+		Name:        body.Name,
+		PhoneNumber: body.PhoneNumber,
+		TimeZone:    body.TimeZone,
+		Channel:     body.Channel,
+	})
+	if err != nil {
+		return userErrorResponse(err)
+	}
+
+	return response.Created(newUserResponse(user))
+}
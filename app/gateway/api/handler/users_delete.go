@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	UsersDeleteCommand = "users-delete"
+	UsersDeletePattern = "/users/{id}"
+)
+
+func (h *Handler) UsersDeleteSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(UsersDeleteCommand)
+	handler := rest.HandleWithCircuit(circuit, UsersDeletePattern, h.UsersDelete)
+
+	router.Delete(UsersDeletePattern, handler)
+}
+
+func (h *Handler) UsersDelete(req *http.Request) *response.Response {
+	if err := h.useCase.DeleteUser(req.Context(), chi.URLParam(req, "id")); err != nil {
+		return userErrorResponse(err)
+	}
+
+	return response.NoContent()
+}
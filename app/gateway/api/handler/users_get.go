@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	UsersGetCommand = "users-get"
+	UsersGetPattern = "/users/{id}"
+)
+
+func (h *Handler) UsersGetSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(UsersGetCommand)
+	handler := rest.HandleWithCircuit(circuit, UsersGetPattern, h.UsersGet)
+
+	router.Get(UsersGetPattern, handler)
+}
+
+func (h *Handler) UsersGet(req *http.Request) *response.Response {
+	user, err := h.useCase.GetUser(req.Context(), chi.URLParam(req, "id"))
+	if err != nil {
+		return userErrorResponse(err)
+	}
+
+	return response.OK(newUserResponse(user))
+}
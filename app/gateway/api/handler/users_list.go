@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+	"github.com/chatbot-go/app/library/cursor"
+)
+
+const (
+	UsersListCommand = "users-list"
+	UsersListPattern = "/users"
+
+	usersListDefaultLimit = 20
+	usersListMaxLimit     = 100
+)
+
+type listUsersRequest struct {
+	Name        string
+	PhoneNumber string
+	CreatedFrom string
+	CreatedTo   string
+	Limit       string
+	Cursor      string
+}
+
+func (r listUsersRequest) Validate() error {
+	return validation.ValidateStruct(&r, //nolint:wrapcheck
+		validation.Field(&r.Name, validation.Length(0, 200)),
+		validation.Field(&r.PhoneNumber, validation.Match(phoneNumberPrefixRegex)),
+		validation.Field(&r.CreatedFrom, validation.Date(time.RFC3339)),
+		validation.Field(&r.CreatedTo, validation.Date(time.RFC3339)),
+		validation.Field(&r.Limit, validation.By(func(value any) error {
+			return validation.Validate(atoiOrZero(value.(string)), validation.Min(1), validation.Max(usersListMaxLimit))
+		})),
+		validation.Field(&r.Cursor, validation.By(validUsersListCursor)),
+	)
+}
+
+func (h *Handler) UsersListSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(UsersListCommand)
+	handler := rest.HandleWithCircuit(circuit, UsersListPattern, h.UsersList)
+
+	router.Get(UsersListPattern, handler)
+}
+
+// UsersList lists users filtered by the name, phone, created_from and
+// created_to query parameters, paginated with limit and cursor.
+func (h *Handler) UsersList(req *http.Request) *response.Response {
+	query := req.URL.Query()
+
+	params := listUsersRequest{
+		Name:        query.Get("name"),
+		PhoneNumber: query.Get("phone"),
+		CreatedFrom: query.Get("created_from"),
+		CreatedTo:   query.Get("created_to"),
+		Limit:       query.Get("limit"),
+		Cursor:      query.Get("cursor"),
+	}
+
+	if params.Limit == "" {
+		params.Limit = strconv.Itoa(usersListDefaultLimit)
+	}
+
+	if err := params.Validate(); err != nil {
+		return response.BadRequest(err, "invalid query parameters")
+	}
+
+	page, err := h.useCase.ListUsers(req.Context(), usecase.ListUsersInput{
+		Name:        params.Name,
+		PhoneNumber: params.PhoneNumber,
+		CreatedFrom: parseOptionalTime(params.CreatedFrom),
+		CreatedTo:   parseOptionalTime(params.CreatedTo),
+		Limit:       atoiOrZero(params.Limit),
+		Cursor:      params.Cursor,
+	})
+	if err != nil {
+		return userErrorResponse(err)
+	}
+
+	payload := usersPageResponse{
+		Users:      make([]userResponse, 0, len(page.Users)),
+		NextCursor: page.NextCursor,
+	}
+
+	for _, user := range page.Users {
+		payload.Users = append(payload.Users, newUserResponse(user))
+	}
+
+	return response.OK(payload)
+}
+
+// validUsersListCursor checks the cursor holds a user id, which the query
+// compares against a bigint column.
+func validUsersListCursor(value any) error {
+	encoded, _ := value.(string)
+	if encoded == "" {
+		return nil
+	}
+
+	values, err := cursor.Decode(encoded, 1)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if _, err := strconv.ParseInt(values[0], 10, 64); err != nil {
+		return cursor.ErrInvalid
+	}
+
+	return nil
+}
+
+func atoiOrZero(value string) int {
+	number, _ := strconv.Atoi(value)
+
+	return number
+}
+
+// parseOptionalTime parses an already validated RFC 3339 time, nil when empty.
+func parseOptionalTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+
+	parsed, _ := time.Parse(time.RFC3339, value)
+
+	return &parsed
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	UsersMessagesCommand = "users-messages"
+	UsersMessagesPattern = "/users/{id}/messages"
+
+	usersMessagesDefaultLimit = 50
+	usersMessagesMaxLimit     = 200
+)
+
+type listUserMessagesRequest struct {
+	CreatedFrom string
+	CreatedTo   string
+	Limit       string
+	Cursor      string
+}
+
+func (r listUserMessagesRequest) Validate() error {
+	return validation.ValidateStruct(&r, //nolint:wrapcheck
+		validation.Field(&r.CreatedFrom, validation.Date(time.RFC3339)),
+		validation.Field(&r.CreatedTo, validation.Date(time.RFC3339)),
+		validation.Field(&r.Limit, validation.By(func(value any) error {
+			return validation.Validate(atoiOrZero(value.(string)), validation.Min(1), validation.Max(usersMessagesMaxLimit))
+		})),
+	)
+}
+
+type userMessagesPageResponse struct {
+	Messages   []userMessageResponse `json:"messages"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
+
+func (h *Handler) UsersMessagesSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(UsersMessagesCommand)
+	handler := rest.HandleWithCircuit(circuit, UsersMessagesPattern, h.UsersMessages)
+
+	router.Get(UsersMessagesPattern, handler)
+}
+
+// UsersMessages lists the inbound and outbound messages of a user in
+// chronological order, filtered by the created_from and created_to query
+// parameters and paginated with limit and cursor.
+func (h *Handler) UsersMessages(req *http.Request) *response.Response {
+	query := req.URL.Query()
+
+	params := listUserMessagesRequest{
+		CreatedFrom: query.Get("created_from"),
+		CreatedTo:   query.Get("created_to"),
+		Limit:       query.Get("limit"),
+		Cursor:      query.Get("cursor"),
+	}
+
+	if params.Limit == "" {
+		params.Limit = strconv.Itoa(usersMessagesDefaultLimit)
+	}
+
+	if err := params.Validate(); err != nil {
+		return response.BadRequest(err, "invalid query parameters")
+	}
+
+	page, err := h.useCase.ListUserMessages(req.Context(), usecase.ListUserMessagesInput{
+		UserID:      chi.URLParam(req, "id"),
+		CreatedFrom: parseOptionalTime(params.CreatedFrom),
+		CreatedTo:   parseOptionalTime(params.CreatedTo),
+		Limit:       atoiOrZero(params.Limit),
+		Cursor:      params.Cursor,
+	})
+	if err != nil {
+		return userErrorResponse(err)
+	}
+
+	payload := userMessagesPageResponse{
+		Messages:   make([]userMessageResponse, 0, len(page.Messages)),
+		NextCursor: page.NextCursor,
+	}
+
+	for _, message := range page.Messages {
+		payload.Messages = append(payload.Messages, newUserMessageResponse(message))
+	}
+
+	return response.OK(payload)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+)
+
+const (
+	UsersUpdateCommand = "users-update"
+	UsersUpdatePattern = "/users/{id}"
+)
+
+// updateUserRequest only changes the fields present in the body.
+type updateUserRequest struct {
+	Name        *string `json:"name"`
+	PhoneNumber *string `json:"phone_number"`
+	TimeZone    *string `json:"time_zone"`
+	Channel     *string `json:"preferred_channel"`
+}
+
+func (r updateUserRequest) Validate() error {
+	return validation.ValidateStruct(&r, //nolint:wrapcheck
+		validation.Field(&r.Name, validation.NilOrNotEmpty, validation.Length(1, 200)),
+		validation.Field(&r.PhoneNumber, validation.NilOrNotEmpty, validation.Match(phoneNumberRegex)),
+		validation.Field(&r.TimeZone, validTimeZone),
+		validation.Field(&r.Channel, validation.NilOrNotEmpty, validChannel),
+	)
+}
+
+func (h *Handler) UsersUpdateSetup(router chi.Router) {
+	circuit := h.circuitManager.MustCreateCircuit(UsersUpdateCommand)
+	handler := rest.HandleWithCircuit(circuit, UsersUpdatePattern, h.UsersUpdate)
+
+	router.Patch(UsersUpdatePattern, handler)
+}
+
+func (h *Handler) UsersUpdate(req *http.Request) *response.Response {
+	var body updateUserRequest
+
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return response.BadRequest(err, "invalid request body")
+	}
+
+	if err := body.Validate(); err != nil {
+		return response.BadRequest(err, "invalid user")
+	}
+
+	user, err := h.useCase.UpdateUser(req.Context(), chi.URLParam(req, "id"), usecase.UpdateUserInput{
+		Name:        body.Name,
+		PhoneNumber: body.PhoneNumber,
+		TimeZone:    body.TimeZone,
+		Channel:     body.Channel,
+	})
+	if err != nil {
+		return userErrorResponse(err)
+	}
+
+	return response.OK(newUserResponse(user))
+}
@@ -1,10 +1,14 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/erring"
 	"github.com/chatbot-go/app/domain/usecase"
 	"github.com/chatbot-go/app/gateway/api/middleware"
 	"github.com/chatbot-go/app/gateway/api/rest"
@@ -17,11 +21,15 @@ const (
 	WebhooksTwilioPattern = "/webhooks/twilio"
 )
 
-func (h *Handler) WebhooksTwilioSetup(router chi.Router, twilioClient *twilio.Client) {
+func (h *Handler) WebhooksTwilioSetup(router chi.Router, twilioTenants *twilio.Tenants) {
 	circuit := h.circuitManager.MustCreateCircuit(WebhooksTwilioCommand)
 	handler := rest.HandleWithCircuit(circuit, WebhooksTwilioPattern, h.WebhooksTwilio)
 
-	router = router.With(middleware.TwilioAuth(twilioClient))
+	// Inbound messages are sent to one of our numbers.
+	router = router.With(
+		middleware.TwilioAuth(twilioTenants, h.useCase, "To"),
+		middleware.WebhookIdempotency(h.cache, h.cfg.Twilio.WebhookIdempotencyTTL, "MessageSid"),
+	)
 
 	router.Post(WebhooksTwilioPattern, handler)
 }
@@ -31,12 +39,45 @@ func (h *Handler) WebhooksTwilio(req *http.Request) *response.Response {
 		MessageBody: req.PostForm.Get("Body"),
 		MessageSid:  req.PostForm.Get("MessageSid"),
 		PhoneNumber: req.PostForm.Get("From"),
+		ProfileName: req.PostForm.Get("ProfileName"),
+		WaID:        req.PostForm.Get("WaId"),
+
+		ButtonPayload: req.PostForm.Get("ButtonPayload"),
+		ButtonText:    req.PostForm.Get("ButtonText"),
+		ListID:        req.PostForm.Get("ListId"),
+		ListTitle:     req.PostForm.Get("ListTitle"),
+
+		Media: webhooksTwilioMedia(req),
 	}
 
 	err := h.useCase.EnqueueTwilioWebhook(req.Context(), input)
+	if errors.Is(err, erring.ErrEventInvalid) {
+		return response.AppExpectedError(err)
+	}
+
 	if err != nil {
 		return response.InternalServerError(err)
 	}
 
 	return response.Accepted(nil)
 }
+
+func webhooksTwilioMedia(req *http.Request) []dto.Media {
+	numMedia, err := strconv.Atoi(req.PostForm.Get("NumMedia"))
+	if err != nil || numMedia <= 0 {
+		return nil
+	}
+
+	media := make([]dto.Media, 0, numMedia)
+
+	for i := 0; i < numMedia; i++ {
+		index := strconv.Itoa(i)
+
+		media = append(media, dto.Media{
+			URL:         req.PostForm.Get("MediaUrl" + index),
+			ContentType: req.PostForm.Get("MediaContentType" + index),
+		})
+	}
+
+	return media
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/gateway/api/middleware"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+	"github.com/chatbot-go/app/gateway/client/twilio"
+)
+
+const (
+	WebhooksTwilioStatusCommand = "webhooks-twilio-status"
+	WebhooksTwilioStatusPattern = "/webhooks/twilio/status"
+)
+
+func (h *Handler) WebhooksTwilioStatusSetup(router chi.Router, twilioTenants *twilio.Tenants) {
+	circuit := h.circuitManager.MustCreateCircuit(WebhooksTwilioStatusCommand)
+	handler := rest.HandleWithCircuit(circuit, WebhooksTwilioStatusPattern, h.WebhooksTwilioStatus)
+
+	// Statuses are reported for messages sent from one of our numbers.
+	// A message goes through several statuses, each reported once.
+	router = router.With(
+		middleware.TwilioAuth(twilioTenants, h.useCase, "From"),
+		middleware.WebhookIdempotency(h.cache, h.cfg.Twilio.WebhookIdempotencyTTL, "MessageSid", "MessageStatus"),
+	)
+
+	router.Post(WebhooksTwilioStatusPattern, handler)
+}
+
+func (h *Handler) WebhooksTwilioStatus(req *http.Request) *response.Response {
+	input := usecase.EnqueueTwilioStatusWebhookInput{
+		MessageSid:    req.PostForm.Get("MessageSid"),
+		MessageStatus: req.PostForm.Get("MessageStatus"),
+		ErrorCode:     req.PostForm.Get("ErrorCode"),
+	}
+
+	err := h.useCase.EnqueueTwilioStatusWebhook(req.Context(), input)
+	if err != nil {
+		return response.InternalServerError(err)
+	}
+
+	return response.Accepted(nil)
+}
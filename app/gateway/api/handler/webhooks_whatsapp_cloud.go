@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/gateway/api/middleware"
+	"github.com/chatbot-go/app/gateway/api/rest"
+	"github.com/chatbot-go/app/gateway/api/rest/response"
+	"github.com/chatbot-go/app/gateway/client/whatsappcloud"
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+const (
+	WebhooksWhatsappCloudCommand = "webhooks-whatsapp-cloud"
+	WebhooksWhatsappCloudPattern = "/webhooks/whatsapp-cloud"
+)
+
+func (h *Handler) WebhooksWhatsappCloudSetup(router chi.Router, cloudClient *whatsappcloud.Client) {
+	circuit := h.circuitManager.MustCreateCircuit(WebhooksWhatsappCloudCommand)
+	handler := rest.HandleWithCircuit(circuit, WebhooksWhatsappCloudPattern, h.WebhooksWhatsappCloud)
+
+	router.Get(WebhooksWhatsappCloudPattern, webhooksWhatsappCloudVerify(cloudClient))
+	router.With(middleware.WhatsappCloudAuth(cloudClient)).Post(WebhooksWhatsappCloudPattern, handler)
+}
+
+// webhooksWhatsappCloudVerify answers the subscription handshake, which
+// expects the challenge echoed back as plain text.
+func webhooksWhatsappCloudVerify(cloudClient *whatsappcloud.Client) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+
+		if query.Get("hub.mode") != "subscribe" || !cloudClient.VerifyToken(query.Get("hub.verify_token")) {
+			rw.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		rw.Header().Set("Content-Type", "text/plain")
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte(query.Get("hub.challenge")))
+	}
+}
+
+// WebhooksWhatsappCloud queues the messages and statuses of a notification.
+// Both are routed to the tenant of the business number of the notification,
+// as Twilio webhooks are by their number; unknown numbers belong to the
+// default tenant.
+func (h *Handler) WebhooksWhatsappCloud(req *http.Request) *response.Response {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return response.InternalServerError(err)
+	}
+
+	messages, statuses, err := whatsappcloud.ParseWebhook(body)
+	if err != nil {
+		return response.AppExpectedError(err)
+	}
+
+	for _, message := range messages {
+		ctx, err := h.whatsappCloudTenant(req.Context(), message.To)
+		if errors.Is(err, erring.ErrEventInvalid) {
+			return response.AppExpectedError(err)
+		}
+
+		if err != nil {
+			return response.InternalServerError(err)
+		}
+
+		err = h.useCase.EnqueueInboundMessage(ctx, message)
+		if errors.Is(err, erring.ErrEventInvalid) {
+			return response.AppExpectedError(err)
+		}
+
+		if err != nil {
+			return response.InternalServerError(err)
+		}
+	}
+
+	for _, status := range statuses {
+		ctx, err := h.whatsappCloudTenant(req.Context(), status.From)
+		if errors.Is(err, erring.ErrEventInvalid) {
+			return response.AppExpectedError(err)
+		}
+
+		if err != nil {
+			return response.InternalServerError(err)
+		}
+
+		err = h.useCase.EnqueueMessageStatus(ctx, status)
+		if err != nil {
+			return response.InternalServerError(err)
+		}
+	}
+
+	return response.Accepted(nil)
+}
+
+// whatsappCloudTenant scopes the context to the tenant of the business number.
+func (h *Handler) whatsappCloudTenant(ctx context.Context, number string) (context.Context, error) {
+	tenant, err := h.useCase.GetTenantByNumber(ctx, number)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return ctxkey.PutTenantID(ctx, tenant.ID), nil
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+// AdminAuth only lets through requests carrying the admin API token, or one
+// of the tenant tokens keyed by tenant id, as a bearer token. A tenant token
+// scopes the request to its tenant. Every request is rejected when no token
+// is configured.
+func AdminAuth(token string, tenantTokens map[string]string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			ctx := req.Context()
+
+			authHeader, _ := ctxkey.GetAuthorizationHeader(ctx)
+
+			bearer, found := strings.CutPrefix(authHeader, "Bearer ")
+			if !found {
+				rw.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+
+			if token != "" && subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) == 1 {
+				next.ServeHTTP(rw, req)
+
+				return
+			}
+
+			tenantID := ""
+
+			for id, tenantToken := range tenantTokens {
+				if tenantToken != "" && subtle.ConstantTimeCompare([]byte(bearer), []byte(tenantToken)) == 1 {
+					tenantID = id
+				}
+			}
+
+			if tenantID == "" {
+				rw.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+
+			next.ServeHTTP(rw, req.WithContext(ctxkey.PutTenantID(ctx, tenantID)))
+		})
+	}
+}
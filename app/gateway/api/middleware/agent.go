@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+// AgentAuth identifies the agent by the bearer token, keyed by agent id in
+// tokens, and copies the agent id to the context. The request is scoped to
+// the agent's tenant in tenants, the default one when missing.
+func AgentAuth(tokens, tenants map[string]string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			ctx := req.Context()
+
+			authHeader, _ := ctxkey.GetAuthorizationHeader(ctx)
+
+			bearer, found := strings.CutPrefix(authHeader, "Bearer ")
+
+			agentID := ""
+
+			for id, token := range tokens {
+				if found && token != "" && subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) == 1 {
+					agentID = id
+				}
+			}
+
+			if agentID == "" {
+				rw.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+
+			tenantID := tenants[agentID]
+			if tenantID == "" {
+				tenantID = entity.DefaultTenantID
+			}
+
+			ctx = ctxkey.PutAgentID(ctx, agentID)
+
+			next.ServeHTTP(rw, req.WithContext(ctxkey.PutTenantID(ctx, tenantID)))
+		})
+	}
+}
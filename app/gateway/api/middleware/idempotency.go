@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+const (
+	idempotencyKeyPrefix = "idempotency:"
+
+	// A webhook is reserved while it is handled, for no longer than this, so
+	// a reservation left by a stopped instance expires.
+	idempotencyPendingTTL = time.Minute
+
+	idempotencyPending = "pending"
+	idempotencyHandled = "handled"
+)
+
+// WebhookIdempotency answers 200 to webhooks already handled, as providers
+// retry them past the SQS deduplication window. A webhook is identified by
+// the form fields, such as MessageSid, and is reserved before it is handled,
+// so a copy arriving meanwhile gets 409 and is retried by the provider. The
+// reservation is kept for the whole ttl once the webhook is handled
+// successfully and dropped otherwise, so failed ones are still retried.
+// Webhooks missing one of the fields, or arriving while the cache is
+// unavailable, are let through.
+func WebhookIdempotency(cache cache, ttl time.Duration, fields ...string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			const operation = "Middleware.WebhookIdempotency"
+
+			ctx := req.Context()
+
+			if err := req.ParseForm(); err != nil {
+				rw.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			values := make([]string, 0, len(fields))
+
+			for _, field := range fields {
+				value := req.PostForm.Get(field)
+				if value == "" {
+					next.ServeHTTP(rw, req)
+
+					return
+				}
+
+				values = append(values, value)
+			}
+
+			key := idempotencyKeyPrefix + req.URL.Path + ":" + strings.Join(values, ":")
+			ctx = ctxkey.PutIdempotencyKey(ctx, key)
+
+			reserved, err := cache.SetNX(ctx, key, idempotencyPending, idempotencyPendingTTL)
+			if err != nil {
+				slog.WarnContext(ctx, fmt.Errorf("%s -> %w", operation, err).Error())
+
+				next.ServeHTTP(rw, req.WithContext(ctx))
+
+				return
+			}
+
+			if !reserved {
+				var state string
+
+				err = cache.Get(ctx, key, &state)
+				if err != nil {
+					slog.WarnContext(ctx, fmt.Errorf("%s -> %w", operation, err).Error())
+				}
+
+				if state == idempotencyHandled {
+					rw.WriteHeader(http.StatusOK)
+				} else {
+					rw.WriteHeader(http.StatusConflict)
+				}
+
+				return
+			}
+
+			wrapped := middleware.NewWrapResponseWriter(rw, req.ProtoMajor)
+
+			next.ServeHTTP(wrapped, req.WithContext(ctx))
+
+			if wrapped.Status() < http.StatusOK || wrapped.Status() >= http.StatusMultipleChoices {
+				_, err = cache.Del(ctx, key)
+			} else {
+				err = cache.Set(ctx, key, idempotencyHandled, ttl)
+			}
+
+			if err != nil {
+				slog.WarnContext(ctx, fmt.Errorf("%s -> %w", operation, err).Error())
+			}
+		})
+	}
+}
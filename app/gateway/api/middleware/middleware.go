@@ -3,11 +3,20 @@ package middleware
 import (
 	"context"
 	"time"
+
+	"github.com/chatbot-go/app/domain/entity"
 )
 
-//go:generate moq -rm -out middleware_mocks.gen.go . cache
+//go:generate moq -rm -out middleware_mocks.gen.go . cache tenants
 
 type cache interface {
-	Exists(ctx context.Context, key string) (bool, error)
+	Get(ctx context.Context, key string, objByRef any) error
 	Set(ctx context.Context, key string, obj any, ttl time.Duration) error
+	SetNX(ctx context.Context, key string, obj any, ttl time.Duration) (bool, error)
+	Del(ctx context.Context, key string) (bool, error)
+}
+
+type tenants interface {
+	GetTenant(ctx context.Context, id string) (entity.Tenant, error)
+	GetTenantByNumber(ctx context.Context, address string) (entity.Tenant, error)
 }
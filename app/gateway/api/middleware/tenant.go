@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/ctxkey"
+)
+
+// TenantFromHeader scopes the request to the tenant in the X-Tenant-ID
+// header, the default one when it is missing. Requests authenticated with a
+// credential bound to a tenant stay in that tenant, and are rejected when the
+// header names another. Requests for unknown tenants are rejected.
+func TenantFromHeader(tenants tenants) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			ctx := req.Context()
+
+			tenantID := req.Header.Get("X-Tenant-ID")
+
+			if bound, ok := ctxkey.GetTenantID(ctx); ok {
+				if tenantID != "" && tenantID != bound {
+					rw.WriteHeader(http.StatusForbidden)
+
+					return
+				}
+
+				tenantID = bound
+			}
+
+			if tenantID == "" {
+				next.ServeHTTP(rw, req)
+
+				return
+			}
+
+			if _, err := strconv.ParseInt(tenantID, 10, 64); err != nil {
+				rw.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			_, err := tenants.GetTenant(ctx, tenantID)
+			if errors.Is(err, erring.ErrTenantNotFound) {
+				rw.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			if err != nil {
+				rw.WriteHeader(http.StatusInternalServerError)
+
+				return
+			}
+
+			next.ServeHTTP(rw, req.WithContext(ctxkey.PutTenantID(ctx, tenantID)))
+		})
+	}
+}
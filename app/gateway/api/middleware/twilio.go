@@ -1,12 +1,18 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/chatbot-go/app/domain/erring"
 	"github.com/chatbot-go/app/gateway/client/twilio"
+	"github.com/chatbot-go/app/library/ctxkey"
 )
 
-func TwilioAuth(twilioClient *twilio.Client) func(next http.Handler) http.Handler {
+// TwilioAuth routes the webhook to the tenant owning the number in the
+// numberParam form field, validates its signature with the auth token of
+// that tenant and copies the tenant id to the context.
+func TwilioAuth(twilioTenants *twilio.Tenants, tenants tenants, numberParam string) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 			ctx := req.Context()
@@ -26,6 +32,21 @@ func TwilioAuth(twilioClient *twilio.Client) func(next http.Handler) http.Handle
 				params[key] = values[0]
 			}
 
+			tenant, err := tenants.GetTenantByNumber(ctx, req.PostForm.Get(numberParam))
+			if errors.Is(err, erring.ErrEventInvalid) {
+				rw.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			if err != nil {
+				rw.WriteHeader(http.StatusInternalServerError)
+
+				return
+			}
+
+			twilioClient := twilioTenants.ForTenant(tenant)
+
 			// Store the X-Twilio-Signature header attached to the request as a variable
 			signature := req.Header.Get("X-Twilio-Signature")
 
@@ -36,7 +57,7 @@ func TwilioAuth(twilioClient *twilio.Client) func(next http.Handler) http.Handle
 				return
 			}
 
-			next.ServeHTTP(rw, req.WithContext(ctx))
+			next.ServeHTTP(rw, req.WithContext(ctxkey.PutTenantID(ctx, tenant.ID)))
 		})
 	}
 }
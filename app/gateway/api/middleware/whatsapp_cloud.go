@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/chatbot-go/app/gateway/client/whatsappcloud"
+)
+
+// maxWebhookBodySize bounds the webhook payloads read to check their
+// signature. Meta batches at most a few messages per notification.
+const maxWebhookBodySize = 1 << 20
+
+// WhatsappCloudAuth only lets through webhooks signed with the app secret.
+// The body is read to check the signature and put back for the handler, and
+// bodies over maxWebhookBodySize are rejected.
+func WhatsappCloudAuth(cloudClient *whatsappcloud.Client) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			body, err := io.ReadAll(http.MaxBytesReader(rw, req.Body, maxWebhookBodySize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					rw.WriteHeader(http.StatusRequestEntityTooLarge)
+
+					return
+				}
+
+				rw.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			if !cloudClient.VerifySignature(body, req.Header.Get("X-Hub-Signature-256")) {
+				rw.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			next.ServeHTTP(rw, req)
+		})
+	}
+}
@@ -14,7 +14,33 @@ type Error struct {
 
 var errorToStatusCode = map[error]int{
 	// Shared
-	erring.ErrEventInvalid: http.StatusBadRequest,
+	erring.ErrEventInvalid:   http.StatusBadRequest,
+	erring.ErrRequestInvalid: http.StatusBadRequest,
+
+	// Users
+	erring.ErrUserNotFound:      http.StatusNotFound,
+	erring.ErrUserAlreadyExists: http.StatusConflict,
+
+	// User messages
+	erring.ErrUserMessageTooLong: http.StatusUnprocessableEntity,
+
+	// Consent
+	erring.ErrUserOptedOut: http.StatusUnprocessableEntity,
+
+	// Templates
+	erring.ErrTemplateNotFound:         http.StatusUnprocessableEntity,
+	erring.ErrTemplateVariablesInvalid: http.StatusUnprocessableEntity,
+	erring.ErrTemplateBodyMissing:      http.StatusUnprocessableEntity,
+
+	// Campaigns
+	erring.ErrCampaignNotFound:          http.StatusNotFound,
+	erring.ErrCampaignTransitionInvalid: http.StatusConflict,
+	erring.ErrCampaignVariablesInvalid:  http.StatusUnprocessableEntity,
+
+	// Handoffs
+	erring.ErrHandoffNotFound:          http.StatusNotFound,
+	erring.ErrHandoffTransitionInvalid: http.StatusConflict,
+	erring.ErrHandoffNotClaimedByAgent: http.StatusForbidden,
 }
 
 func StatusCodeFromError(err error) int {
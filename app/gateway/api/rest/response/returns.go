@@ -131,7 +131,7 @@ func InternalServerError(err error) *Response {
 }
 
 func AppError(err error) *Response {
-	appError := new(erring.AppError)
+	var appError erring.AppError
 	if errors.As(err, &appError) {
 		status := StatusCodeFromError(appError)
 
@@ -156,7 +156,7 @@ func AppExpectedError(err error) *Response {
 }
 
 func makeBadRequestError(err error, message string) Error {
-	appError := new(erring.AppError)
+	var appError erring.AppError
 	if errors.As(err, &appError) {
 		return Error{
 			Type:    string(resource.SrnErrorBadRequest),
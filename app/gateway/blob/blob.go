@@ -0,0 +1,40 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/chatbot-go/app/config"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var ErrUnknownDriver = errors.New("blob: unknown driver")
+
+// Store saves objects under a key and returns the number of bytes written.
+type Store interface {
+	Put(ctx context.Context, key, contentType string, body io.Reader) (int64, error)
+}
+
+func New(ctx context.Context, cfg config.Blob) (Store, error) {
+	const operation = "Blob.New"
+
+	switch cfg.Driver {
+	case DriverLocal:
+		return NewLocalStore(cfg.LocalDir), nil
+	case DriverS3:
+		store, err := NewS3Store(ctx, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		return store, nil
+	default:
+		return nil, fmt.Errorf("%s (%s) -> %w", operation, cfg.Driver, ErrUnknownDriver)
+	}
+}
@@ -0,0 +1,42 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// LocalStore keeps objects as files under a base directory.
+type LocalStore struct {
+	dir string
+}
+
+func NewLocalStore(dir string) *LocalStore {
+	return &LocalStore{dir: dir}
+}
+
+func (s *LocalStore) Put(_ context.Context, key, _ string, body io.Reader) (int64, error) {
+	const operation = "Blob.LocalStore.Put"
+
+	path := filepath.Join(s.dir, filepath.Clean("/"+key))
+
+	err := os.MkdirAll(filepath.Dir(path), 0o755) //nolint:gomnd
+	if err != nil {
+		return 0, fmt.Errorf("%s (%s) -> %w", operation, key, err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("%s (%s) -> %w", operation, key, err)
+	}
+	defer file.Close()
+
+	size, err := io.Copy(file, body)
+	if err != nil {
+		return 0, fmt.Errorf("%s (%s) -> %w", operation, key, err)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,74 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+
+	"github.com/chatbot-go/app/config"
+)
+
+// S3Store keeps objects in an S3 bucket. Any S3-compatible service can be
+// used by setting a custom endpoint.
+type S3Store struct {
+	client *awss3.Client
+	bucket string
+}
+
+func NewS3Store(ctx context.Context, cfg config.Blob) (*S3Store, error) {
+	const operation = "Blob.NewS3Store"
+
+	loadOptions := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(cfg.S3Region)}
+
+	if cfg.S3AccessKeyID != "" {
+		loadOptions = append(loadOptions, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(cfg.S3AccessKeyID, cfg.S3SecretAccessKey, ""),
+		))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, loadOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	otelaws.AppendMiddlewares(&awsCfg.APIOptions)
+
+	client := awss3.NewFromConfig(awsCfg, func(opts *awss3.Options) {
+		if cfg.S3Endpoint != "" {
+			opts.BaseEndpoint = aws.String(cfg.S3Endpoint)
+		}
+
+		opts.UsePathStyle = cfg.S3UsePathStyle
+	})
+
+	return &S3Store{client: client, bucket: cfg.S3Bucket}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key, contentType string, body io.Reader) (int64, error) {
+	const operation = "Blob.S3Store.Put"
+
+	// The request payload must be seekable to be signed.
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return 0, fmt.Errorf("%s (%s) -> %w", operation, key, err)
+	}
+
+	_, err = s.client.PutObject(ctx, &awss3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+		Body:        bytes.NewReader(data),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s (%s) -> %w", operation, key, err)
+	}
+
+	return int64(len(data)), nil
+}
@@ -1,28 +1,55 @@
 package twilio
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/twilio/twilio-go"
 	validatorClient "github.com/twilio/twilio-go/client"
 
 	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/domain/dto"
 )
 
+const mediaDownloadTimeout = 30 * time.Second
+
 type Client struct {
 	client              *twilio.RestClient
+	httpClient          *http.Client
 	RequestValidator    validatorClient.RequestValidator
+	accountSID          string
+	authToken           string
 	originNumber        string
 	messagingServiceSid string
+	statusCallbackURL   string
 }
 
 func NewClient(twilioConfig config.Twilio) *Client {
-	client := twilio.NewRestClient()
+	client := twilio.NewRestClientWithParams(twilio.ClientParams{
+		Username: twilioConfig.AccountSID,
+		Password: twilioConfig.AuthToken,
+	})
 
 	requestValidator := validatorClient.NewRequestValidator(twilioConfig.AuthToken)
 
 	return &Client{
 		client:              client,
+		httpClient:          &http.Client{Timeout: mediaDownloadTimeout},
 		RequestValidator:    requestValidator,
+		accountSID:          twilioConfig.AccountSID,
+		authToken:           twilioConfig.AuthToken,
 		originNumber:        twilioConfig.OriginNumber,
 		messagingServiceSid: twilioConfig.MessagingServiceSid,
+		statusCallbackURL:   twilioConfig.StatusCallbackURL,
+	}
+}
+
+// address builds the Twilio address of a number on the channel. SMS numbers
+// are used as they are, other channels are prefixed with their name.
+func address(provider dto.Provider, number string) string {
+	if provider == dto.SMSProvider {
+		return number
 	}
+
+	return string(provider) + ":" + number
 }
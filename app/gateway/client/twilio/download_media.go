@@ -0,0 +1,36 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+// DownloadMedia fetches an inbound media file. The caller must close the
+// returned body.
+func (c *Client) DownloadMedia(ctx context.Context, url string) (io.ReadCloser, string, error) {
+	const operation = "Client.Twilio.DownloadMedia"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	req.SetBasicAuth(c.accountSID, c.authToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+
+		return nil, "", fmt.Errorf("%s (status %d) -> %w", operation, resp.StatusCode, erring.ErrClientResponseInvalid)
+	}
+
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
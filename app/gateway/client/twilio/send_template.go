@@ -12,33 +12,37 @@ import (
 )
 
 //nolint:revive
-func (c *Client) SendMessageTemplate(ctx context.Context, input dto.SendMessageTemplateInput) error {
-	const operation = "Client.Twilio.SendMessage"
+func (c *Client) SendTemplate(ctx context.Context, input dto.SendMessageTemplateInput) (string, error) {
+	const operation = "Client.Twilio.SendTemplate"
 
 	params := &api.CreateMessageParams{}
-	params.SetFrom(string(input.Provider) + ":" + c.originNumber)
-	params.SetTo(string(input.Provider) + ":" + input.DestinationNumber)
+	params.SetFrom(address(input.Provider, c.originNumber))
+	params.SetTo(address(input.Provider, input.DestinationNumber))
 	params.SetMessagingServiceSid(c.messagingServiceSid)
-	params.SetContentSid(string(input.TemplateID))
+	params.SetContentSid(input.ContentSID)
 
 	if input.Variables != nil {
 		mapVariables, err := json.Marshal(input.Variables)
 		if err != nil {
-			return fmt.Errorf("%s -> %w", operation, err)
+			return "", fmt.Errorf("%s -> %w", operation, err)
 		}
 
 		params.SetContentVariables(string(mapVariables))
 	}
 
+	if c.statusCallbackURL != "" {
+		params.SetStatusCallback(c.statusCallbackURL)
+	}
+
 	resp, err := c.client.Api.CreateMessage(params)
 	if err != nil {
-		return fmt.Errorf("%s -> %w", operation, err)
+		return "", fmt.Errorf("%s -> %w", operation, err)
 	}
 
 	// TODO: try to validate a case where SID is empty
 	if resp.Sid == nil {
-		return fmt.Errorf("%s -> %w", operation, erring.ErrMissingTwilioSid)
+		return "", fmt.Errorf("%s -> %w", operation, erring.ErrMissingTwilioSid)
 	}
 
-	return nil
+	return *resp.Sid, nil
 }
@@ -0,0 +1,37 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/twilio/twilio-go/rest/api/v2010"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+//nolint:revive
+func (c *Client) SendText(ctx context.Context, input dto.SendMessageInput) (string, error) {
+	const operation = "Client.Twilio.SendText"
+
+	params := &api.CreateMessageParams{}
+	params.SetFrom(address(input.Provider, c.originNumber))
+	params.SetBody(input.Message)
+	params.SetTo(address(input.Provider, input.DestinationNumber))
+
+	if c.statusCallbackURL != "" {
+		params.SetStatusCallback(c.statusCallbackURL)
+	}
+
+	resp, err := c.client.Api.CreateMessage(params)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	// TODO: try to validate a case where SID is empty
+	if resp.Sid == nil {
+		return "", fmt.Errorf("%s -> %w", operation, erring.ErrMissingTwilioSid)
+	}
+
+	return *resp.Sid, nil
+}
@@ -0,0 +1,120 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+type tenantsRepository interface {
+	GetByID(ctx context.Context, id string) (entity.Tenant, error)
+}
+
+// Tenants sends through the Twilio account of the tenant in the context.
+// Tenants without their own account use the one of the config.
+type Tenants struct {
+	Default    *Client
+	repository tenantsRepository
+
+	mu      sync.Mutex
+	clients map[string]tenantClient
+}
+
+type tenantClient struct {
+	client    *Client
+	updatedAt time.Time
+}
+
+func NewTenants(defaultClient *Client, repository tenantsRepository) *Tenants {
+	return &Tenants{
+		Default:    defaultClient,
+		repository: repository,
+		clients:    map[string]tenantClient{},
+	}
+}
+
+// ForTenant returns the client of the tenant account, built again whenever
+// the tenant is updated.
+func (t *Tenants) ForTenant(tenant entity.Tenant) *Client {
+	if tenant.UsesDefaultAccount() {
+		return t.Default
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if cached, ok := t.clients[tenant.ID]; ok && cached.updatedAt.Equal(tenant.UpdatedAt) {
+		return cached.client
+	}
+
+	client := NewClient(config.Twilio{
+		AccountSID:          tenant.TwilioAccountSID,
+		AuthToken:           tenant.TwilioAuthToken,
+		OriginNumber:        tenant.OriginNumber,
+		MessagingServiceSid: tenant.TwilioMessagingServiceSid,
+		StatusCallbackURL:   tenant.TwilioStatusCallbackURL,
+	})
+
+	t.clients[tenant.ID] = tenantClient{client: client, updatedAt: tenant.UpdatedAt}
+
+	return client
+}
+
+func (t *Tenants) client(ctx context.Context) (*Client, error) {
+	tenant, err := t.repository.GetByID(ctx, entity.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return t.ForTenant(tenant), nil
+}
+
+func (t *Tenants) SendText(ctx context.Context, input dto.SendMessageInput) (string, error) {
+	const operation = "Client.Twilio.Tenants.SendText"
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return client.SendText(ctx, input)
+}
+
+func (t *Tenants) SendTemplate(ctx context.Context, input dto.SendMessageTemplateInput) (string, error) {
+	const operation = "Client.Twilio.Tenants.SendTemplate"
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return client.SendTemplate(ctx, input)
+}
+
+func (t *Tenants) SendMedia(ctx context.Context, input dto.SendMediaInput) (string, error) {
+	const operation = "Client.Twilio.Tenants.SendMedia"
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return client.SendMedia(ctx, input)
+}
+
+func (t *Tenants) DownloadMedia(ctx context.Context, url string) (io.ReadCloser, string, error) {
+	const operation = "Client.Twilio.Tenants.DownloadMedia"
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return client.DownloadMedia(ctx, url)
+}
@@ -0,0 +1,116 @@
+package whatsappcloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+// Client speaks the WhatsApp Cloud API of the Meta Graph API. The base URL
+// is configurable so it can be pointed at a local stand-in server.
+type Client struct {
+	httpClient    *http.Client
+	baseURL       string
+	phoneNumberID string
+	accessToken   string
+	appSecret     string
+	verifyToken   string
+}
+
+func NewClient(cloudConfig config.WhatsappCloud) *Client {
+	return &Client{
+		httpClient:    &http.Client{Timeout: cloudConfig.Timeout},
+		baseURL:       strings.TrimSuffix(cloudConfig.BaseURL, "/"),
+		phoneNumberID: cloudConfig.PhoneNumberID,
+		accessToken:   cloudConfig.AccessToken,
+		appSecret:     cloudConfig.AppSecret,
+		verifyToken:   cloudConfig.VerifyToken,
+	}
+}
+
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+	} `json:"error"`
+}
+
+// do sends a Graph API request and decodes the JSON response into out.
+func (c *Client) do(ctx context.Context, method, url string, body, out any) error {
+	var reader io.Reader
+
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		var errResp errorResponse
+
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+
+		return fmt.Errorf("status %d, code %d: %s: %w", resp.StatusCode, errResp.Error.Code, errResp.Error.Message, erring.ErrClientResponseInvalid)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out) //nolint:wrapcheck
+}
+
+// VerifySignature checks the X-Hub-Signature-256 header of a webhook, the
+// HMAC-SHA256 of the body with the app secret. Every payload is rejected
+// when the secret is not configured.
+func (c *Client) VerifySignature(body []byte, signature string) bool {
+	if c.appSecret == "" {
+		return false
+	}
+
+	expected, found := strings.CutPrefix(signature, "sha256=")
+	if !found {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(c.appSecret))
+	mac.Write(body)
+
+	return hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(expected))
+}
+
+// VerifyToken tells whether the token sent by Meta when subscribing the
+// webhook is the configured one.
+func (c *Client) VerifyToken(token string) bool {
+	return c.verifyToken != "" && hmac.Equal([]byte(token), []byte(c.verifyToken))
+}
@@ -0,0 +1,395 @@
+package whatsappcloud_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/gateway/client/whatsappcloud"
+)
+
+const (
+	testPhoneNumberID = "1234567890"
+	testAccessToken   = "access-token"
+	testAppSecret     = "app-secret"
+	testVerifyToken   = "verify-token"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *whatsappcloud.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return whatsappcloud.NewClient(config.WhatsappCloud{
+		BaseURL:       server.URL + "/",
+		PhoneNumberID: testPhoneNumberID,
+		AccessToken:   testAccessToken,
+		Timeout:       time.Second,
+		AppSecret:     testAppSecret,
+		VerifyToken:   testVerifyToken,
+	})
+}
+
+// messagesHandler checks the request is an authenticated post to the
+// messages endpoint, decodes its body into sent and answers with id.
+func messagesHandler(t *testing.T, sent *map[string]any, id string) http.HandlerFunc {
+	t.Helper()
+
+	return func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/"+testPhoneNumberID+"/messages" {
+			t.Errorf("request = %s %s, want POST /%s/messages", req.Method, req.URL.Path, testPhoneNumberID)
+		}
+
+		if got := req.Header.Get("Authorization"); got != "Bearer "+testAccessToken {
+			t.Errorf("Authorization = %q", got)
+		}
+
+		if got := req.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Content-Type = %q", got)
+		}
+
+		if err := json.NewDecoder(req.Body).Decode(sent); err != nil {
+			t.Errorf("decoding the request body: %v", err)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(rw, `{"messaging_product":"whatsapp","messages":[{"id":"`+id+`"}]}`)
+	}
+}
+
+func assertJSON(t *testing.T, got map[string]any, want string) {
+	t.Helper()
+
+	var expected map[string]any
+	if err := json.Unmarshal([]byte(want), &expected); err != nil {
+		t.Fatalf("decoding the expected body: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		gotJSON, _ := json.Marshal(got)
+		t.Errorf("body = %s, want %s", gotJSON, want)
+	}
+}
+
+func TestClient_SendText(t *testing.T) {
+	t.Parallel()
+
+	var sent map[string]any
+
+	client := newTestClient(t, messagesHandler(t, &sent, "wamid.text"))
+
+	id, err := client.SendText(context.Background(), dto.SendMessageInput{
+		Provider:          dto.WhatsappProvider,
+		DestinationNumber: "+55 11 91234-5678",
+		Message:           "Olá",
+	})
+	if err != nil {
+		t.Fatalf("SendText() error = %v", err)
+	}
+
+	if id != "wamid.text" {
+		t.Errorf("SendText() = %q, want wamid.text", id)
+	}
+
+	assertJSON(t, sent, `{
+		"messaging_product": "whatsapp",
+		"recipient_type": "individual",
+		"to": "5511912345678",
+		"type": "text",
+		"text": {"body": "Olá", "preview_url": false}
+	}`)
+}
+
+func TestClient_SendTemplate(t *testing.T) {
+	t.Parallel()
+
+	var sent map[string]any
+
+	client := newTestClient(t, messagesHandler(t, &sent, "wamid.template"))
+
+	id, err := client.SendTemplate(context.Background(), dto.SendMessageTemplateInput{
+		Provider:          dto.WhatsappProvider,
+		DestinationNumber: "+5511912345678",
+		TemplateName:      "welcome",
+		Locale:            "pt_BR",
+		Variables:         map[string]string{"10": "ten", "2": "two", "1": "one"},
+	})
+	if err != nil {
+		t.Fatalf("SendTemplate() error = %v", err)
+	}
+
+	if id != "wamid.template" {
+		t.Errorf("SendTemplate() = %q, want wamid.template", id)
+	}
+
+	assertJSON(t, sent, `{
+		"messaging_product": "whatsapp",
+		"recipient_type": "individual",
+		"to": "5511912345678",
+		"type": "template",
+		"template": {
+			"name": "welcome",
+			"language": {"code": "pt_BR"},
+			"components": [{
+				"type": "body",
+				"parameters": [
+					{"type": "text", "text": "one"},
+					{"type": "text", "text": "two"},
+					{"type": "text", "text": "ten"}
+				]
+			}]
+		}
+	}`)
+}
+
+func TestClient_SendMedia(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mediaURL string
+		want     string
+	}{
+		{
+			name:     "image",
+			mediaURL: "https://cdn.example.com/a.JPG?size=large",
+			want:     `{"type": "image", "image": {"link": "https://cdn.example.com/a.JPG?size=large", "caption": "caption"}}`,
+		},
+		{
+			name:     "video",
+			mediaURL: "https://cdn.example.com/a.mp4",
+			want:     `{"type": "video", "video": {"link": "https://cdn.example.com/a.mp4", "caption": "caption"}}`,
+		},
+		{
+			name:     "audio drops the caption",
+			mediaURL: "https://cdn.example.com/a.ogg",
+			want:     `{"type": "audio", "audio": {"link": "https://cdn.example.com/a.ogg"}}`,
+		},
+		{
+			name:     "document",
+			mediaURL: "https://cdn.example.com/a.pdf",
+			want:     `{"type": "document", "document": {"link": "https://cdn.example.com/a.pdf", "caption": "caption"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var sent map[string]any
+
+			client := newTestClient(t, messagesHandler(t, &sent, "wamid.media"))
+
+			id, err := client.SendMedia(context.Background(), dto.SendMediaInput{
+				Provider:          dto.WhatsappProvider,
+				DestinationNumber: "+5511912345678",
+				MediaURL:          tt.mediaURL,
+				Caption:           "caption",
+			})
+			if err != nil {
+				t.Fatalf("SendMedia() error = %v", err)
+			}
+
+			if id != "wamid.media" {
+				t.Errorf("SendMedia() = %q, want wamid.media", id)
+			}
+
+			delete(sent, "messaging_product")
+			delete(sent, "recipient_type")
+			delete(sent, "to")
+
+			assertJSON(t, sent, tt.want)
+		})
+	}
+}
+
+func TestClient_SendError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{
+			name:   "graph error",
+			status: http.StatusBadRequest,
+			body:   `{"error":{"message":"Invalid parameter","code":100}}`,
+		},
+		{
+			name:   "message id missing",
+			status: http.StatusOK,
+			body:   `{"messaging_product":"whatsapp","messages":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClient(t, func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(tt.status)
+				_, _ = io.WriteString(rw, tt.body)
+			})
+
+			_, err := client.SendText(context.Background(), dto.SendMessageInput{
+				DestinationNumber: "+5511912345678",
+				Message:           "Olá",
+			})
+			if !errors.Is(err, erring.ErrClientResponseInvalid) {
+				t.Errorf("SendText() error = %v, want %v", err, erring.ErrClientResponseInvalid)
+			}
+		})
+	}
+}
+
+func TestClient_DownloadMedia(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		mimeType        string
+		wantContentType string
+	}{
+		{name: "graph mime type", mimeType: "audio/ogg; codecs=opus", wantContentType: "audio/ogg; codecs=opus"},
+		{name: "content type fallback", mimeType: "", wantContentType: "image/jpeg"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+				if got := req.Header.Get("Authorization"); got != "Bearer "+testAccessToken {
+					t.Errorf("%s Authorization = %q", req.URL.Path, got)
+				}
+
+				switch req.URL.Path {
+				case "/media-id":
+					_ = json.NewEncoder(rw).Encode(map[string]string{
+						"url":       "http://" + req.Host + "/files/media-id",
+						"mime_type": tt.mimeType,
+					})
+				case "/files/media-id":
+					rw.Header().Set("Content-Type", "image/jpeg")
+					_, _ = io.WriteString(rw, "media content")
+				default:
+					rw.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			body, contentType, err := client.DownloadMedia(context.Background(), "media-id")
+			if err != nil {
+				t.Fatalf("DownloadMedia() error = %v", err)
+			}
+			defer body.Close()
+
+			content, err := io.ReadAll(body)
+			if err != nil {
+				t.Fatalf("reading the media: %v", err)
+			}
+
+			if string(content) != "media content" {
+				t.Errorf("DownloadMedia() content = %q, want %q", content, "media content")
+			}
+
+			if contentType != tt.wantContentType {
+				t.Errorf("DownloadMedia() content type = %q, want %q", contentType, tt.wantContentType)
+			}
+		})
+	}
+}
+
+func TestClient_DownloadMediaNotFound(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/media-id" {
+			_ = json.NewEncoder(rw).Encode(map[string]string{"url": "http://" + req.Host + "/files/media-id"})
+
+			return
+		}
+
+		rw.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := client.DownloadMedia(context.Background(), "media-id")
+	if !errors.Is(err, erring.ErrClientResponseInvalid) {
+		t.Errorf("DownloadMedia() error = %v, want %v", err, erring.ErrClientResponseInvalid)
+	}
+}
+
+func TestClient_VerifySignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"object":"whatsapp_business_account"}`)
+
+	mac := hmac.New(sha256.New, []byte(testAppSecret))
+	mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name      string
+		appSecret string
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", appSecret: testAppSecret, body: body, signature: signature, want: true},
+		{name: "tampered body", appSecret: testAppSecret, body: []byte(`{}`), signature: signature},
+		{name: "missing prefix", appSecret: testAppSecret, body: body, signature: signature[len("sha256="):]},
+		{name: "missing header", appSecret: testAppSecret, body: body},
+		{name: "secret not configured", body: body, signature: signature},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := whatsappcloud.NewClient(config.WhatsappCloud{AppSecret: tt.appSecret})
+
+			if got := client.VerifySignature(tt.body, tt.signature); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_VerifyToken(t *testing.T) {
+	t.Parallel()
+
+	client := whatsappcloud.NewClient(config.WhatsappCloud{VerifyToken: testVerifyToken})
+
+	if !client.VerifyToken(testVerifyToken) {
+		t.Error("VerifyToken() = false for the configured token")
+	}
+
+	if client.VerifyToken("other") {
+		t.Error("VerifyToken() = true for another token")
+	}
+
+	if whatsappcloud.NewClient(config.WhatsappCloud{}).VerifyToken("") {
+		t.Error("VerifyToken() = true without a configured token")
+	}
+}
@@ -0,0 +1,54 @@
+package whatsappcloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+type mediaResponse struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+}
+
+// DownloadMedia fetches an inbound media file by its id, resolving the
+// short-lived URL it is served from first. The caller must close the
+// returned body.
+func (c *Client) DownloadMedia(ctx context.Context, id string) (io.ReadCloser, string, error) {
+	const operation = "Client.WhatsappCloud.DownloadMedia"
+
+	var resolved mediaResponse
+
+	err := c.do(ctx, http.MethodGet, c.baseURL+"/"+id, nil, &resolved)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resolved.URL, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+
+		return nil, "", fmt.Errorf("%s (status %d) -> %w", operation, resp.StatusCode, erring.ErrClientResponseInvalid)
+	}
+
+	contentType := resolved.MimeType
+	if contentType == "" {
+		contentType = resp.Header.Get("Content-Type")
+	}
+
+	return resp.Body, contentType, nil
+}
@@ -0,0 +1,55 @@
+package whatsappcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/library/util"
+)
+
+type message struct {
+	MessagingProduct string `json:"messaging_product"`
+	RecipientType    string `json:"recipient_type"`
+	To               string `json:"to"`
+	Type             string `json:"type"`
+
+	Text     *text     `json:"text,omitempty"`
+	Template *template `json:"template,omitempty"`
+	Image    *media    `json:"image,omitempty"`
+	Video    *media    `json:"video,omitempty"`
+	Audio    *media    `json:"audio,omitempty"`
+	Document *media    `json:"document,omitempty"`
+}
+
+type sendResponse struct {
+	Messages []struct {
+		ID string `json:"id"`
+	} `json:"messages"`
+}
+
+func newMessage(destinationNumber, messageType string) message {
+	return message{
+		MessagingProduct: "whatsapp",
+		RecipientType:    "individual",
+		To:               util.KeepNumbers(destinationNumber),
+		Type:             messageType,
+	}
+}
+
+// send posts the message and returns its WhatsApp message id.
+func (c *Client) send(ctx context.Context, msg message) (string, error) {
+	var resp sendResponse
+
+	err := c.do(ctx, http.MethodPost, c.baseURL+"/"+c.phoneNumberID+"/messages", msg, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	if len(resp.Messages) == 0 || resp.Messages[0].ID == "" {
+		return "", fmt.Errorf("message id is missing: %w", erring.ErrClientResponseInvalid)
+	}
+
+	return resp.Messages[0].ID, nil
+}
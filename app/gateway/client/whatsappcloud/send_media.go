@@ -0,0 +1,64 @@
+package whatsappcloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/chatbot-go/app/domain/dto"
+)
+
+type media struct {
+	Link    string `json:"link"`
+	Caption string `json:"caption,omitempty"`
+}
+
+// SendMedia sends the file at the URL, its type being taken from the file
+// extension. Audio messages cannot have a caption, so it is dropped.
+func (c *Client) SendMedia(ctx context.Context, input dto.SendMediaInput) (string, error) {
+	const operation = "Client.WhatsappCloud.SendMedia"
+
+	item := &media{Link: input.MediaURL, Caption: input.Caption}
+
+	mediaType := mediaTypeFromURL(input.MediaURL)
+	msg := newMessage(input.DestinationNumber, mediaType)
+
+	switch mediaType {
+	case "image":
+		msg.Image = item
+	case "video":
+		msg.Video = item
+	case "audio":
+		item.Caption = ""
+		msg.Audio = item
+	default:
+		msg.Document = item
+	}
+
+	id, err := c.send(ctx, msg)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return id, nil
+}
+
+func mediaTypeFromURL(rawURL string) string {
+	name := rawURL
+	if parsed, err := url.Parse(rawURL); err == nil {
+		name = parsed.Path
+	}
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".jpg", ".jpeg", ".png":
+		return "image"
+	case ".mp4", ".3gp":
+		return "video"
+	case ".mp3", ".ogg", ".aac", ".amr", ".m4a":
+		return "audio"
+	default:
+		return "document"
+	}
+}
@@ -0,0 +1,88 @@
+package whatsappcloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/chatbot-go/app/domain/dto"
+)
+
+type template struct {
+	Name       string              `json:"name"`
+	Language   templateLanguage    `json:"language"`
+	Components []templateComponent `json:"components,omitempty"`
+}
+
+type templateLanguage struct {
+	Code string `json:"code"`
+}
+
+type templateComponent struct {
+	Type       string              `json:"type"`
+	Parameters []templateParameter `json:"parameters"`
+}
+
+type templateParameter struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// SendTemplate sends a template approved in the WhatsApp Manager under the
+// catalog name and locale. Its body parameters are positional, so the
+// variables are sent in the order of their numeric names.
+func (c *Client) SendTemplate(ctx context.Context, input dto.SendMessageTemplateInput) (string, error) {
+	const operation = "Client.WhatsappCloud.SendTemplate"
+
+	msg := newMessage(input.DestinationNumber, "template")
+	msg.Template = &template{
+		Name:     input.TemplateName,
+		Language: templateLanguage{Code: input.Locale},
+	}
+
+	if len(input.Variables) > 0 {
+		names := make([]string, 0, len(input.Variables))
+		for name := range input.Variables {
+			names = append(names, name)
+		}
+
+		slices.SortFunc(names, compareVariableNames)
+
+		parameters := make([]templateParameter, 0, len(names))
+		for _, name := range names {
+			parameters = append(parameters, templateParameter{Type: "text", Text: input.Variables[name]})
+		}
+
+		msg.Template.Components = []templateComponent{{Type: "body", Parameters: parameters}}
+	}
+
+	id, err := c.send(ctx, msg)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return id, nil
+}
+
+// compareVariableNames orders numeric names by value and the others after
+// them, alphabetically.
+func compareVariableNames(a, b string) int {
+	numberA, errA := strconv.Atoi(a)
+	numberB, errB := strconv.Atoi(b)
+
+	switch {
+	case errA == nil && errB == nil:
+		return numberA - numberB
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
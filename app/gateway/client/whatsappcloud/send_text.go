@@ -0,0 +1,27 @@
+package whatsappcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/dto"
+)
+
+type text struct {
+	Body       string `json:"body"`
+	PreviewURL bool   `json:"preview_url"`
+}
+
+func (c *Client) SendText(ctx context.Context, input dto.SendMessageInput) (string, error) {
+	const operation = "Client.WhatsappCloud.SendText"
+
+	msg := newMessage(input.DestinationNumber, "text")
+	msg.Text = &text{Body: input.Message}
+
+	id, err := c.send(ctx, msg)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return id, nil
+}
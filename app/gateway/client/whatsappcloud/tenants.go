@@ -0,0 +1,120 @@
+package whatsappcloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+type tenantsRepository interface {
+	GetByID(ctx context.Context, id string) (entity.Tenant, error)
+}
+
+// Tenants sends through the WhatsApp Cloud number of the tenant in the
+// context. Tenants without their own number use the one of the config.
+type Tenants struct {
+	Default    *Client
+	config     config.WhatsappCloud
+	repository tenantsRepository
+
+	mu      sync.Mutex
+	clients map[string]tenantClient
+}
+
+type tenantClient struct {
+	client    *Client
+	updatedAt time.Time
+}
+
+func NewTenants(defaultClient *Client, cloudConfig config.WhatsappCloud, repository tenantsRepository) *Tenants {
+	return &Tenants{
+		Default:    defaultClient,
+		config:     cloudConfig,
+		repository: repository,
+		clients:    map[string]tenantClient{},
+	}
+}
+
+// ForTenant returns the client of the tenant number, built again whenever
+// the tenant is updated.
+func (t *Tenants) ForTenant(tenant entity.Tenant) *Client {
+	if tenant.UsesDefaultCloudAccount() {
+		return t.Default
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if cached, ok := t.clients[tenant.ID]; ok && cached.updatedAt.Equal(tenant.UpdatedAt) {
+		return cached.client
+	}
+
+	tenantConfig := t.config
+	tenantConfig.PhoneNumberID = tenant.WhatsappCloudPhoneNumberID
+	tenantConfig.AccessToken = tenant.WhatsappCloudAccessToken
+
+	client := NewClient(tenantConfig)
+
+	t.clients[tenant.ID] = tenantClient{client: client, updatedAt: tenant.UpdatedAt}
+
+	return client
+}
+
+func (t *Tenants) client(ctx context.Context) (*Client, error) {
+	tenant, err := t.repository.GetByID(ctx, entity.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return t.ForTenant(tenant), nil
+}
+
+func (t *Tenants) SendText(ctx context.Context, input dto.SendMessageInput) (string, error) {
+	const operation = "Client.WhatsappCloud.Tenants.SendText"
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return client.SendText(ctx, input)
+}
+
+func (t *Tenants) SendTemplate(ctx context.Context, input dto.SendMessageTemplateInput) (string, error) {
+	const operation = "Client.WhatsappCloud.Tenants.SendTemplate"
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return client.SendTemplate(ctx, input)
+}
+
+func (t *Tenants) SendMedia(ctx context.Context, input dto.SendMediaInput) (string, error) {
+	const operation = "Client.WhatsappCloud.Tenants.SendMedia"
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return client.SendMedia(ctx, input)
+}
+
+func (t *Tenants) DownloadMedia(ctx context.Context, url string) (io.ReadCloser, string, error) {
+	const operation = "Client.WhatsappCloud.Tenants.DownloadMedia"
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return client.DownloadMedia(ctx, url)
+}
@@ -0,0 +1,186 @@
+package whatsappcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+type webhook struct {
+	Object string `json:"object"`
+	Entry  []struct {
+		Changes []struct {
+			Field string       `json:"field"`
+			Value webhookValue `json:"value"`
+		} `json:"changes"`
+	} `json:"entry"`
+}
+
+type webhookValue struct {
+	Metadata struct {
+		DisplayPhoneNumber string `json:"display_phone_number"`
+		PhoneNumberID      string `json:"phone_number_id"`
+	} `json:"metadata"`
+	Contacts []struct {
+		Profile struct {
+			Name string `json:"name"`
+		} `json:"profile"`
+		WaID string `json:"wa_id"`
+	} `json:"contacts"`
+	Messages []webhookMessage `json:"messages"`
+	Statuses []webhookStatus  `json:"statuses"`
+}
+
+type webhookMessage struct {
+	From string `json:"from"`
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Text struct {
+		Body string `json:"body"`
+	} `json:"text"`
+	Interactive struct {
+		Type        string      `json:"type"`
+		ButtonReply replyOption `json:"button_reply"`
+		ListReply   replyOption `json:"list_reply"`
+	} `json:"interactive"`
+	Button struct {
+		Payload string `json:"payload"`
+		Text    string `json:"text"`
+	} `json:"button"`
+	Image    *webhookMedia `json:"image"`
+	Video    *webhookMedia `json:"video"`
+	Audio    *webhookMedia `json:"audio"`
+	Document *webhookMedia `json:"document"`
+	Sticker  *webhookMedia `json:"sticker"`
+}
+
+type replyOption struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type webhookMedia struct {
+	ID       string `json:"id"`
+	MimeType string `json:"mime_type"`
+	Caption  string `json:"caption"`
+}
+
+type webhookStatus struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Errors []struct {
+		Code int `json:"code"`
+	} `json:"errors"`
+}
+
+// ParseWebhook reads the messages and delivery statuses of a webhook
+// notification. Message types the bot does not handle, such as locations
+// and reactions, are kept with an empty body. Messages and statuses carry
+// the business number of the notification metadata.
+func ParseWebhook(body []byte) ([]dto.InboundMessage, []dto.MessageStatusUpdate, error) {
+	const operation = "Client.WhatsappCloud.ParseWebhook"
+
+	var payload webhook
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s -> %w: %w", operation, erring.ErrEventInvalid, err)
+	}
+
+	if payload.Object != "whatsapp_business_account" {
+		return nil, nil, fmt.Errorf("%s (%s) -> %w", operation, payload.Object, erring.ErrEventInvalid)
+	}
+
+	var (
+		messages []dto.InboundMessage
+		statuses []dto.MessageStatusUpdate
+	)
+
+	for _, entry := range payload.Entry {
+		for _, change := range entry.Changes {
+			if change.Field != "messages" {
+				continue
+			}
+
+			profileNames := make(map[string]string, len(change.Value.Contacts))
+			for _, contact := range change.Value.Contacts {
+				profileNames[contact.WaID] = contact.Profile.Name
+			}
+
+			for _, message := range change.Value.Messages {
+				inbound := inboundMessage(message, profileNames[message.From])
+				inbound.To = change.Value.Metadata.DisplayPhoneNumber
+
+				messages = append(messages, inbound)
+			}
+
+			for _, status := range change.Value.Statuses {
+				update := statusUpdate(status)
+				update.From = change.Value.Metadata.DisplayPhoneNumber
+
+				statuses = append(statuses, update)
+			}
+		}
+	}
+
+	return messages, statuses, nil
+}
+
+func inboundMessage(message webhookMessage, profileName string) dto.InboundMessage {
+	inbound := dto.InboundMessage{
+		MessageSid:  message.ID,
+		PhoneNumber: message.From,
+		ProfileName: profileName,
+		WaID:        message.From,
+		Provider:    dto.WhatsappProvider,
+	}
+
+	switch message.Type {
+	case "text":
+		inbound.MessageBody = message.Text.Body
+	case "interactive":
+		switch message.Interactive.Type {
+		case "button_reply":
+			reply := message.Interactive.ButtonReply
+			inbound.MessageBody = reply.Title
+			inbound.InteractiveReply = &dto.InteractiveReply{Type: dto.ButtonReply, ID: reply.ID, Title: reply.Title}
+		case "list_reply":
+			reply := message.Interactive.ListReply
+			inbound.MessageBody = reply.Title
+			inbound.InteractiveReply = &dto.InteractiveReply{Type: dto.ListReply, ID: reply.ID, Title: reply.Title}
+		}
+	case "button":
+		inbound.MessageBody = message.Button.Text
+		inbound.InteractiveReply = &dto.InteractiveReply{Type: dto.ButtonReply, ID: message.Button.Payload, Title: message.Button.Text}
+	}
+
+	for _, item := range []*webhookMedia{message.Image, message.Video, message.Audio, message.Document, message.Sticker} {
+		if item == nil {
+			continue
+		}
+
+		inbound.Media = append(inbound.Media, dto.Media{URL: item.ID, ContentType: item.MimeType})
+
+		if inbound.MessageBody == "" {
+			inbound.MessageBody = item.Caption
+		}
+	}
+
+	return inbound
+}
+
+func statusUpdate(status webhookStatus) dto.MessageStatusUpdate {
+	update := dto.MessageStatusUpdate{
+		MessageSid: status.ID,
+		Status:     status.Status,
+	}
+
+	if len(status.Errors) > 0 {
+		update.ErrorCode = strconv.Itoa(status.Errors[0].Code)
+	}
+
+	return update
+}
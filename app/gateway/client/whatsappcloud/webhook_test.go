@@ -0,0 +1,178 @@
+package whatsappcloud_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/gateway/client/whatsappcloud"
+)
+
+func TestParseWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		body         string
+		wantMessages []dto.InboundMessage
+		wantStatuses []dto.MessageStatusUpdate
+	}{
+		{
+			name: "text",
+			body: `{"object":"whatsapp_business_account","entry":[{"changes":[{"field":"messages","value":{
+				"metadata":{"display_phone_number":"551130001000","phone_number_id":"1234567890"},
+				"contacts":[{"profile":{"name":"Maria"},"wa_id":"5511912345678"}],
+				"messages":[{"from":"5511912345678","id":"wamid.1","type":"text","text":{"body":"Oi"}}]
+			}}]}]}`,
+			wantMessages: []dto.InboundMessage{{
+				MessageSid:  "wamid.1",
+				MessageBody: "Oi",
+				PhoneNumber: "5511912345678",
+				ProfileName: "Maria",
+				WaID:        "5511912345678",
+				Provider:    dto.WhatsappProvider,
+				To:          "551130001000",
+			}},
+		},
+		{
+			name: "button reply",
+			body: `{"object":"whatsapp_business_account","entry":[{"changes":[{"field":"messages","value":{
+				"messages":[{"from":"5511912345678","id":"wamid.2","type":"interactive",
+					"interactive":{"type":"button_reply","button_reply":{"id":"yes","title":"Sim"}}}]
+			}}]}]}`,
+			wantMessages: []dto.InboundMessage{{
+				MessageSid:       "wamid.2",
+				MessageBody:      "Sim",
+				PhoneNumber:      "5511912345678",
+				WaID:             "5511912345678",
+				Provider:         dto.WhatsappProvider,
+				InteractiveReply: &dto.InteractiveReply{Type: dto.ButtonReply, ID: "yes", Title: "Sim"},
+			}},
+		},
+		{
+			name: "list reply",
+			body: `{"object":"whatsapp_business_account","entry":[{"changes":[{"field":"messages","value":{
+				"messages":[{"from":"5511912345678","id":"wamid.3","type":"interactive",
+					"interactive":{"type":"list_reply","list_reply":{"id":"plan-2","title":"Plano 2"}}}]
+			}}]}]}`,
+			wantMessages: []dto.InboundMessage{{
+				MessageSid:       "wamid.3",
+				MessageBody:      "Plano 2",
+				PhoneNumber:      "5511912345678",
+				WaID:             "5511912345678",
+				Provider:         dto.WhatsappProvider,
+				InteractiveReply: &dto.InteractiveReply{Type: dto.ListReply, ID: "plan-2", Title: "Plano 2"},
+			}},
+		},
+		{
+			name: "template quick reply",
+			body: `{"object":"whatsapp_business_account","entry":[{"changes":[{"field":"messages","value":{
+				"messages":[{"from":"5511912345678","id":"wamid.4","type":"button",
+					"button":{"payload":"stop","text":"Parar"}}]
+			}}]}]}`,
+			wantMessages: []dto.InboundMessage{{
+				MessageSid:       "wamid.4",
+				MessageBody:      "Parar",
+				PhoneNumber:      "5511912345678",
+				WaID:             "5511912345678",
+				Provider:         dto.WhatsappProvider,
+				InteractiveReply: &dto.InteractiveReply{Type: dto.ButtonReply, ID: "stop", Title: "Parar"},
+			}},
+		},
+		{
+			name: "image with caption",
+			body: `{"object":"whatsapp_business_account","entry":[{"changes":[{"field":"messages","value":{
+				"messages":[{"from":"5511912345678","id":"wamid.5","type":"image",
+					"image":{"id":"media-id","mime_type":"image/jpeg","caption":"Comprovante"}}]
+			}}]}]}`,
+			wantMessages: []dto.InboundMessage{{
+				MessageSid:  "wamid.5",
+				MessageBody: "Comprovante",
+				PhoneNumber: "5511912345678",
+				WaID:        "5511912345678",
+				Provider:    dto.WhatsappProvider,
+				Media:       []dto.Media{{URL: "media-id", ContentType: "image/jpeg"}},
+			}},
+		},
+		{
+			name: "unhandled type",
+			body: `{"object":"whatsapp_business_account","entry":[{"changes":[{"field":"messages","value":{
+				"messages":[{"from":"5511912345678","id":"wamid.6","type":"location","location":{"latitude":-23.5}}]
+			}}]}]}`,
+			wantMessages: []dto.InboundMessage{{
+				MessageSid:  "wamid.6",
+				PhoneNumber: "5511912345678",
+				WaID:        "5511912345678",
+				Provider:    dto.WhatsappProvider,
+			}},
+		},
+		{
+			name: "statuses",
+			body: `{"object":"whatsapp_business_account","entry":[{"changes":[{"field":"messages","value":{
+				"metadata":{"display_phone_number":"551130001000","phone_number_id":"1234567890"},
+				"statuses":[
+					{"id":"wamid.7","status":"delivered"},
+					{"id":"wamid.8","status":"failed","errors":[{"code":131047}]}
+				]
+			}}]}]}`,
+			wantStatuses: []dto.MessageStatusUpdate{
+				{MessageSid: "wamid.7", Status: "delivered", From: "551130001000"},
+				{MessageSid: "wamid.8", Status: "failed", ErrorCode: "131047", From: "551130001000"},
+			},
+		},
+		{
+			name: "other fields are ignored",
+			body: `{"object":"whatsapp_business_account","entry":[{"changes":[{"field":"account_update","value":{
+				"messages":[{"from":"5511912345678","id":"wamid.9","type":"text","text":{"body":"Oi"}}]
+			}}]}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			messages, statuses, err := whatsappcloud.ParseWebhook([]byte(tt.body))
+			if err != nil {
+				t.Fatalf("ParseWebhook() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(messages, tt.wantMessages) {
+				t.Errorf("ParseWebhook() messages = %+v, want %+v", messages, tt.wantMessages)
+			}
+
+			if !reflect.DeepEqual(statuses, tt.wantStatuses) {
+				t.Errorf("ParseWebhook() statuses = %+v, want %+v", statuses, tt.wantStatuses)
+			}
+		})
+	}
+}
+
+func TestParseWebhookInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed json", body: `{"object":`},
+		{name: "other object", body: `{"object":"page","entry":[]}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := whatsappcloud.ParseWebhook([]byte(tt.body))
+			if !errors.Is(err, erring.ErrEventInvalid) {
+				t.Errorf("ParseWebhook() error = %v, want %v", err, erring.ErrEventInvalid)
+			}
+		})
+	}
+}
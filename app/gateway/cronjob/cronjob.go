@@ -1,12 +1,15 @@
 package cronjob
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/chatbot-go/app/domain/entity"
 	"github.com/chatbot-go/app/domain/types"
+	"github.com/chatbot-go/app/library/ctxkey"
 )
 
 func New(useCase useCase) *cli.App {
@@ -15,16 +18,68 @@ func New(useCase useCase) *cli.App {
 	return &cli.App{
 		Commands: []*cli.Command{
 			{
-				Name:  string(types.SendMessage),
-				Usage: "Send mgm referrals payment requests",
+				Name:  string(types.SendCampaigns),
+				Usage: "Send the campaigns that are due",
 				Action: runJobAction(func(ctx *cli.Context) error {
-					return handler.SendMessage(ctx.Context)
-				}, handler, types.SendMessage),
+					return handler.SendCampaigns(ctx.Context)
+				}, handler, types.SendCampaigns),
+			},
+			{
+				Name:  string(types.SendScheduledMessages),
+				Usage: "Send messages deferred by the quiet hours",
+				Action: runJobAction(func(ctx *cli.Context) error {
+					return handler.SendScheduledMessages(ctx.Context)
+				}, handler, types.SendScheduledMessages),
+			},
+			{
+				Name:  ImportUsersCommand,
+				Usage: "Import users from a CSV file with name, phone and attribute columns",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "file", Usage: "path of the CSV file", Required: true},
+					&cli.IntFlag{Name: "batch-size", Usage: "users written per batch", Value: 1000}, //nolint:gomnd
+					&cli.BoolFlag{Name: "dry-run", Usage: "validate and count the users without writing them"},
+					tenantFlag,
+				},
+				// Imports run on demand, so they are not tracked in the jobs
+				// control table.
+				Action: func(ctx *cli.Context) error {
+					return handler.ImportUsers(tenantContext(ctx), ctx.App.Writer, ctx.String("file"), ctx.Int("batch-size"), ctx.Bool("dry-run"))
+				},
+			},
+			{
+				Name:  ExportConversationsCommand,
+				Usage: "Export the messages of the users as CSV or JSONL",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "format", Usage: "csv or jsonl", Value: string(types.ExportJSONL)},
+					&cli.StringFlag{Name: "output", Usage: "path of the file to write, - for stdout", Value: exportStdout},
+					&cli.StringFlag{Name: "from", Usage: "export messages created from this date or RFC 3339 time"},
+					&cli.StringFlag{Name: "to", Usage: "export messages created before this date or RFC 3339 time"},
+					&cli.StringFlag{Name: "user-id", Usage: "export the messages of a single user"},
+					tenantFlag,
+				},
+				// Export runs are recorded in their own table.
+				Action: func(ctx *cli.Context) error {
+					return handler.ExportConversations(tenantContext(ctx), ctx.App.Writer, ctx.App.ErrWriter, ExportConversationsOptions{
+						Format: types.ExportFormat(ctx.String("format")),
+						Output: ctx.String("output"),
+						From:   ctx.String("from"),
+						To:     ctx.String("to"),
+						UserID: ctx.String("user-id"),
+					})
+				},
 			},
 		},
 	}
 }
 
+// tenantFlag picks the tenant of the commands working on a single one. The
+// scheduled jobs go through every tenant instead.
+var tenantFlag = &cli.StringFlag{Name: "tenant-id", Usage: "tenant whose users are handled", Value: entity.DefaultTenantID}
+
+func tenantContext(ctx *cli.Context) context.Context {
+	return ctxkey.PutTenantID(ctx.Context, ctx.String("tenant-id"))
+}
+
 func runJobAction(action cli.ActionFunc, handler *Handler, jobID types.Job) cli.ActionFunc {
 	return func(cliCtx *cli.Context) error {
 		err := handler.useCase.CreateJobsControl(cliCtx.Context, jobID)
@@ -0,0 +1,107 @@
+package cronjob
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/chatbot-go/app/domain/types"
+	"github.com/chatbot-go/app/domain/usecase"
+)
+
+const (
+	ExportConversationsCommand = "export-conversations"
+
+	// Output path writing the export to stdout.
+	exportStdout = "-"
+)
+
+type ExportConversationsOptions struct {
+	Format types.ExportFormat
+	Output string
+	From   string
+	To     string
+	UserID string
+}
+
+// ExportConversations exports the conversations to the output file, or to
+// stdout, and prints a summary of the run to log.
+func (h *Handler) ExportConversations(ctx context.Context, stdout, log io.Writer, options ExportConversationsOptions) error {
+	const operation = "Cronjob.Handler.ExportConversations"
+
+	from, err := parseExportTime(options.From)
+	if err != nil {
+		return fmt.Errorf("%s (from) -> %w", operation, err)
+	}
+
+	to, err := parseExportTime(options.To)
+	if err != nil {
+		return fmt.Errorf("%s (to) -> %w", operation, err)
+	}
+
+	output := exportOutput{Writer: bufio.NewWriter(stdout), close: func() error { return nil }}
+	destination := "stdout"
+
+	if options.Output != exportStdout {
+		file, err := os.Create(options.Output)
+		if err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		output = exportOutput{Writer: bufio.NewWriter(file), close: file.Close}
+		destination = options.Output
+	}
+
+	run, err := h.useCase.ExportConversations(ctx, usecase.ExportConversationsInput{
+		Output:      output,
+		Format:      options.Format,
+		Destination: destination,
+		CreatedFrom: from,
+		CreatedTo:   to,
+		UserID:      options.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	fmt.Fprintf(log, "export %s: %d messages written to %s\n", run.ID, run.RowsExported, destination)
+
+	return nil
+}
+
+// exportOutput buffers the writes to the output and flushes them before
+// closing it.
+type exportOutput struct {
+	*bufio.Writer
+
+	close func() error
+}
+
+func (o exportOutput) Close() error {
+	if err := o.Flush(); err != nil {
+		return errors.Join(err, o.close())
+	}
+
+	return o.close()
+}
+
+// parseExportTime accepts a date, taken as midnight UTC, or an RFC 3339 time.
+func parseExportTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	parsed, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		parsed, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("%q must be a date or an RFC 3339 time: %w", value, err)
+		}
+	}
+
+	return &parsed, nil
+}
@@ -3,13 +3,18 @@ package cronjob
 import (
 	"context"
 
+	"github.com/chatbot-go/app/domain/entity"
 	"github.com/chatbot-go/app/domain/types"
+	"github.com/chatbot-go/app/domain/usecase"
 )
 
 //go:generate moq -fmt goimports -out handler_mocks.gen.go . useCase
 
 type useCase interface {
-	SendMessage(ctx context.Context) error
+	SendCampaigns(ctx context.Context) error
+	SendScheduledMessages(ctx context.Context) error
+	ImportUsers(ctx context.Context, input usecase.ImportUsersInput) (usecase.ImportUsersReport, error)
+	ExportConversations(ctx context.Context, input usecase.ExportConversationsInput) (entity.ExportRun, error)
 	CreateJobsControl(ctx context.Context, jobID types.Job) error
 	UpdateJobsControl(ctx context.Context, jobID types.Job) error
 }
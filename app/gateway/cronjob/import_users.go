@@ -0,0 +1,53 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/chatbot-go/app/domain/usecase"
+)
+
+const ImportUsersCommand = "import-users"
+
+// ImportUsers imports the users of a CSV file and prints the report to out.
+func (h *Handler) ImportUsers(ctx context.Context, out io.Writer, path string, batchSize int, dryRun bool) error {
+	const operation = "Cronjob.Handler.ImportUsers"
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer file.Close()
+
+	report, err := h.useCase.ImportUsers(ctx, usecase.ImportUsersInput{
+		File:      file,
+		BatchSize: batchSize,
+		DryRun:    dryRun,
+	})
+
+	// The partial report is still printed when a batch fails, previous
+	// batches having been written.
+	printImportUsersReport(out, report, dryRun)
+
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
+
+func printImportUsersReport(out io.Writer, report usecase.ImportUsersReport, dryRun bool) {
+	if dryRun {
+		fmt.Fprintln(out, "dry run, nothing was written")
+	}
+
+	fmt.Fprintf(out, "inserted: %d\n", report.Inserted)
+	fmt.Fprintf(out, "updated: %d\n", report.Updated)
+	fmt.Fprintf(out, "rejected: %d\n", len(report.Rejected))
+
+	for _, rejection := range report.Rejected {
+		fmt.Fprintf(out, "  line %d: %s\n", rejection.Line, rejection.Reason)
+	}
+}
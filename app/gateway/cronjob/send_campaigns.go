@@ -0,0 +1,17 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+)
+
+func (h *Handler) SendCampaigns(ctx context.Context) error {
+	const operation = "Cronjob.Handler.SendCampaigns"
+
+	err := h.useCase.SendCampaigns(ctx)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
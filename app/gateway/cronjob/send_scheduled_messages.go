@@ -0,0 +1,17 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+)
+
+func (h *Handler) SendScheduledMessages(ctx context.Context) error {
+	const operation = "Cronjob.Handler.SendScheduledMessages"
+
+	err := h.useCase.SendScheduledMessages(ctx)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
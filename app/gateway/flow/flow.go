@@ -0,0 +1,167 @@
+package flow
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+// Flows shipped with the binary, used when no directory is configured.
+//
+//go:embed flows
+var FlowsFS embed.FS
+
+type file struct {
+	ID    string     `json:"id"    yaml:"id"`
+	Start string     `json:"start" yaml:"start"`
+	Nodes []fileNode `json:"nodes" yaml:"nodes"`
+}
+
+type fileNode struct {
+	ID                string            `json:"id"                 yaml:"id"`
+	Message           string            `json:"message"            yaml:"message"`
+	Template          string            `json:"template"           yaml:"template"`
+	TemplateVariables map[string]string `json:"template_variables" yaml:"template_variables"`
+	MediaURL          string            `json:"media_url"          yaml:"media_url"`
+	SaveAs            string            `json:"save_as"            yaml:"save_as"`
+	Fallback          string            `json:"fallback"           yaml:"fallback"`
+	Handoff           bool              `json:"handoff"            yaml:"handoff"`
+	Transitions       []fileTransition  `json:"transitions"        yaml:"transitions"`
+}
+
+type fileTransition struct {
+	Match []string `json:"match" yaml:"match"`
+	Next  string   `json:"next"  yaml:"next"`
+}
+
+// Load reads and validates every .json, .yaml and .yml flow definition in dir
+// against the template catalog of each tenant, keyed by tenant id, since
+// every tenant runs the same flows. The embedded flows are used when dir is
+// empty.
+func Load(dir string, templates map[string][]entity.Template) (map[string]entity.Flow, error) {
+	const operation = "Flow.Load"
+
+	fsys, root := fs.FS(FlowsFS), "flows"
+	if dir != "" {
+		fsys, root = os.DirFS(dir), "."
+	}
+
+	entries, err := fs.ReadDir(fsys, root)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	flows := make(map[string]entity.Flow)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		name := path.Join(root, entry.Name())
+
+		flow, err := loadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("%s (%s) -> %w", operation, name, err)
+		}
+
+		if flow.ID == "" {
+			continue
+		}
+
+		if _, ok := flows[flow.ID]; ok {
+			return nil, fmt.Errorf("%s (%s) -> %w: duplicated flow id %q", operation, name, erring.ErrFlowInvalid, flow.ID)
+		}
+
+		for tenantID, tenantTemplates := range templates {
+			if err := Validate(flow, tenantTemplates); err != nil {
+				return nil, fmt.Errorf("%s (%s, tenant %s) -> %w", operation, name, tenantID, err)
+			}
+		}
+
+		flows[flow.ID] = flow
+	}
+
+	if len(flows) == 0 {
+		return nil, fmt.Errorf("%s -> %w", operation, erring.ErrFlowNotFound)
+	}
+
+	return flows, nil
+}
+
+func loadFile(fsys fs.FS, name string) (entity.Flow, error) {
+	const operation = "Flow.loadFile"
+
+	var unmarshal func([]byte, any) error
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		unmarshal = json.Unmarshal
+	case ".yaml", ".yml":
+		unmarshal = yaml.Unmarshal
+	default:
+		return entity.Flow{}, nil
+	}
+
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return entity.Flow{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	var definition file
+
+	if err := unmarshal(data, &definition); err != nil {
+		return entity.Flow{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if definition.ID == "" {
+		return entity.Flow{}, fmt.Errorf("%s -> %w: missing flow id", operation, erring.ErrFlowInvalid)
+	}
+
+	return definition.toEntity()
+}
+
+func (f file) toEntity() (entity.Flow, error) {
+	flow := entity.Flow{
+		ID:        f.ID,
+		StartNode: f.Start,
+		Nodes:     make(map[string]entity.FlowNode, len(f.Nodes)),
+	}
+
+	for _, node := range f.Nodes {
+		if _, ok := flow.Nodes[node.ID]; ok {
+			return entity.Flow{}, fmt.Errorf("%w: duplicated node id %q", erring.ErrFlowInvalid, node.ID)
+		}
+
+		transitions := make([]entity.FlowTransition, 0, len(node.Transitions))
+		for _, transition := range node.Transitions {
+			transitions = append(transitions, entity.FlowTransition{
+				Match: transition.Match,
+				Next:  transition.Next,
+			})
+		}
+
+		flow.Nodes[node.ID] = entity.FlowNode{
+			ID:                node.ID,
+			Message:           node.Message,
+			Template:          node.Template,
+			TemplateVariables: node.TemplateVariables,
+			MediaURL:          node.MediaURL,
+			SaveAs:            node.SaveAs,
+			Fallback:          node.Fallback,
+			Handoff:           node.Handoff,
+			Transitions:       transitions,
+		}
+	}
+
+	return flow, nil
+}
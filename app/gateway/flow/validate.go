@@ -0,0 +1,130 @@
+package flow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+// Validate checks that every node has a reply, every transition points to an
+// existing node, every node is reachable from the start node and templates
+// exist in the catalog and receive the variables they declare.
+func Validate(flow entity.Flow, templates []entity.Template) error {
+	var errs error
+
+	if _, ok := flow.Node(flow.StartNode); !ok {
+		errs = errors.Join(errs, fmt.Errorf("%w: start node %q does not exist", erring.ErrFlowInvalid, flow.StartNode))
+	}
+
+	for id, node := range flow.Nodes {
+		if id == "" {
+			errs = errors.Join(errs, fmt.Errorf("%w: node without id", erring.ErrFlowInvalid))
+		}
+
+		if (node.Message == "") == (node.Template == "") {
+			errs = errors.Join(errs, fmt.Errorf("%w: node %q must have either a message or a template", erring.ErrFlowInvalid, id))
+		}
+
+		if node.MediaURL != "" && node.Template != "" {
+			errs = errors.Join(errs, fmt.Errorf("%w: node %q media must be sent with a message", erring.ErrFlowInvalid, id))
+		}
+
+		if node.Template != "" {
+			errs = errors.Join(errs, validateTemplate(node, templates))
+		}
+
+		for _, transition := range node.Transitions {
+			if _, ok := flow.Node(transition.Next); !ok {
+				errs = errors.Join(errs, fmt.Errorf("%w: node %q transitions to unknown node %q", erring.ErrFlowInvalid, id, transition.Next))
+			}
+		}
+	}
+
+	reachable := reachableNodes(flow)
+
+	for id := range flow.Nodes {
+		if !reachable[id] {
+			errs = errors.Join(errs, fmt.Errorf("%w: node %q is unreachable", erring.ErrFlowInvalid, id))
+		}
+	}
+
+	if errs != nil {
+		return fmt.Errorf("flow %q: %w", flow.ID, errs)
+	}
+
+	return nil
+}
+
+func validateTemplate(node entity.FlowNode, templates []entity.Template) error {
+	var errs error
+
+	found := false
+
+	// Locales of the same template share the variable schema, but each one is
+	// checked so a translation missing a variable is caught as well.
+	for _, template := range templates {
+		if template.Name != node.Template {
+			continue
+		}
+
+		found = true
+
+		template = withoutPlaceholderLimits(template, node.TemplateVariables)
+
+		if err := template.ValidateVariables(node.TemplateVariables); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%w: node %q template %q (%s): %w", erring.ErrFlowInvalid, node.ID, node.Template, template.Locale, err))
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%w: node %q uses unknown template %q", erring.ErrFlowInvalid, node.ID, node.Template)
+	}
+
+	return errs
+}
+
+// withoutPlaceholderLimits drops the max length of the variables whose value
+// holds a {{placeholder}}: their length is only known once rendered, and the
+// rendered values are validated again when the template is sent.
+func withoutPlaceholderLimits(template entity.Template, variables map[string]string) entity.Template {
+	schema := make([]entity.TemplateVariable, len(template.Variables))
+
+	for i, variable := range template.Variables {
+		if strings.Contains(variables[variable.Name], "{{") {
+			variable.MaxLength = 0
+		}
+
+		schema[i] = variable
+	}
+
+	template.Variables = schema
+
+	return template
+}
+
+func reachableNodes(flow entity.Flow) map[string]bool {
+	reachable := map[string]bool{}
+
+	queue := []string{flow.StartNode}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		node, ok := flow.Node(id)
+		if !ok || reachable[id] {
+			continue
+		}
+
+		reachable[id] = true
+
+		for _, transition := range node.Transitions {
+			queue = append(queue, transition.Next)
+		}
+	}
+
+	return reachable
+}
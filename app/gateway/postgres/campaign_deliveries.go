@@ -0,0 +1,9 @@
+package postgres
+
+type CampaignDeliveriesRepository struct {
+	*Client
+}
+
+func NewCampaignDeliveriesRepository(client *Client) *CampaignDeliveriesRepository {
+	return &CampaignDeliveriesRepository{client}
+}
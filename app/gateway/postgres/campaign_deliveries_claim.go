@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Claim moves a queued delivery to sending. Only one worker can claim it, so
+// false means it is being or was already handled. Claims taken before
+// staleBefore were left by a worker that stopped and are taken over.
+func (r *CampaignDeliveriesRepository) Claim(ctx context.Context, campaignID, userID string, staleBefore time.Time) (bool, error) {
+	const (
+		operation = "Repository.CampaignDeliveriesRepository.Claim"
+		query     = `
+			UPDATE campaign_deliveries SET
+				status = 'sending',
+				claimed_at = CURRENT_TIMESTAMP,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE campaign_id = $1
+				AND user_id = $2
+				AND (
+					status = 'queued'
+					OR (status = 'sending' AND claimed_at < $3)
+				)
+		`
+	)
+
+	tag, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		campaignID,
+		userID,
+		staleBefore,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
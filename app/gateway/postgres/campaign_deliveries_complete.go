@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// Complete records the final status of a claimed delivery and counts it in
+// the campaign progress, in the same statement so counts match the ledger.
+func (r *CampaignDeliveriesRepository) Complete(ctx context.Context, campaignID, userID string, status types.CampaignDeliveryStatus) error {
+	const (
+		operation = "Repository.CampaignDeliveriesRepository.Complete"
+		query     = `
+			WITH updated AS (
+				UPDATE campaign_deliveries SET
+					status = $3,
+					updated_at = CURRENT_TIMESTAMP
+				WHERE campaign_id = $1
+					AND user_id = $2
+					AND status = 'sending'
+				RETURNING campaign_id
+			)
+			UPDATE campaigns SET
+				sent_count = sent_count + CASE WHEN $3 = 'sent' THEN 1 ELSE 0 END,
+				skipped_count = skipped_count + CASE WHEN $3 = 'skipped' THEN 1 ELSE 0 END,
+				failed_count = failed_count + CASE WHEN $3 = 'failed' THEN 1 ELSE 0 END,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id IN (SELECT campaign_id FROM updated)
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		campaignID,
+		userID,
+		status,
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// CountUnsettled counts the deliveries of the campaign that are still to be
+// sent: queued, being sent or deferred by the quiet hours.
+func (r *CampaignDeliveriesRepository) CountUnsettled(ctx context.Context, campaignID string) (int, error) {
+	const (
+		operation = "Repository.CampaignDeliveriesRepository.CountUnsettled"
+		query     = `
+			SELECT COUNT(*)
+			FROM campaign_deliveries
+			WHERE campaign_id = $1
+				AND status IN ('queued', 'sending', 'deferred')
+		`
+	)
+
+	var count int
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		campaignID,
+	).Scan(
+		&count,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return count, nil
+}
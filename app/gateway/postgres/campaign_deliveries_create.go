@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// Create adds the delivery to the ledger. When the user already has one for
+// the campaign it is returned untouched and created is false.
+func (r *CampaignDeliveriesRepository) Create(ctx context.Context, delivery entity.CampaignDelivery) (entity.CampaignDelivery, bool, error) {
+	const (
+		operation = "Repository.CampaignDeliveriesRepository.Create"
+		query     = `
+			INSERT INTO campaign_deliveries (campaign_id, user_id, status)
+				VALUES ($1, $2, $3)
+			ON CONFLICT (campaign_id, user_id) DO UPDATE SET
+				status = campaign_deliveries.status
+			RETURNING status, created_at, updated_at, (xmax = 0)
+		`
+	)
+
+	var created bool
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		delivery.CampaignID,
+		delivery.UserID,
+		delivery.Status,
+	).Scan(
+		&delivery.Status,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+		&created,
+	)
+	if err != nil {
+		return entity.CampaignDelivery{}, false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return delivery, created, nil
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// Release gives a claimed delivery back to the queue after a send that did
+// not reach Twilio, so it can be retried.
+func (r *CampaignDeliveriesRepository) Release(ctx context.Context, campaignID, userID string) error {
+	const (
+		operation = "Repository.CampaignDeliveriesRepository.Release"
+		query     = `
+			UPDATE campaign_deliveries SET
+				status = 'queued',
+				claimed_at = NULL,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE campaign_id = $1
+				AND user_id = $2
+				AND status = 'sending'
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		campaignID,
+		userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
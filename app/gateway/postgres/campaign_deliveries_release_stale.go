@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ReleaseStale gives back to the queue the deliveries of the campaign claimed
+// before claimedBefore and never settled, as when the worker stopped, and
+// those queued before queuedBefore and never claimed, as when the queue
+// message was dropped. It returns their users so the messages are queued again.
+func (r *CampaignDeliveriesRepository) ReleaseStale(ctx context.Context, campaignID string, claimedBefore, queuedBefore time.Time) ([]string, error) {
+	const (
+		operation = "Repository.CampaignDeliveriesRepository.ReleaseStale"
+		query     = `
+			UPDATE campaign_deliveries SET
+				status = 'queued',
+				claimed_at = NULL,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE campaign_id = $1
+				AND (
+					(status = 'sending' AND claimed_at < $2)
+					OR (status = 'queued' AND claimed_at IS NULL AND updated_at < $3)
+				)
+			RETURNING user_id
+		`
+	)
+
+	rows, err := r.Client.Pool.Query(ctx, query, campaignID, claimedBefore, queuedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+
+	for rows.Next() {
+		var userID string
+
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return userIDs, nil
+}
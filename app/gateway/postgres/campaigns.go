@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+type CampaignsRepository struct {
+	*Client
+}
+
+func NewCampaignsRepository(client *Client) *CampaignsRepository {
+	return &CampaignsRepository{client}
+}
+
+const campaignColumns = `
+	id,
+	tenant_id::text,
+	name,
+	template_name,
+	locale,
+	audience,
+	variables,
+	status,
+	scheduled_at,
+	started_at,
+	completed_at,
+	COALESCE(cursor_user_id::text, ''),
+	target_count,
+	queued_count,
+	sent_count,
+	deferred_count,
+	skipped_count,
+	failed_count,
+	created_at,
+	updated_at
+`
+
+func scanCampaign(row pgx.Row) (entity.Campaign, error) {
+	var campaign entity.Campaign
+
+	err := row.Scan(
+		&campaign.ID,
+		&campaign.TenantID,
+		&campaign.Name,
+		&campaign.TemplateName,
+		&campaign.Locale,
+		&campaign.Audience,
+		&campaign.Variables,
+		&campaign.Status,
+		&campaign.ScheduledAt,
+		&campaign.StartedAt,
+		&campaign.CompletedAt,
+		&campaign.Progress.CursorUserID,
+		&campaign.Progress.Target,
+		&campaign.Progress.Queued,
+		&campaign.Progress.Sent,
+		&campaign.Progress.Deferred,
+		&campaign.Progress.Skipped,
+		&campaign.Progress.Failed,
+		&campaign.CreatedAt,
+		&campaign.UpdatedAt,
+	)
+
+	return campaign, err //nolint:wrapcheck
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+func (r *CampaignsRepository) Create(ctx context.Context, campaign entity.Campaign) (entity.Campaign, error) {
+	const operation = "Repository.CampaignsRepository.Create"
+
+	query := `
+		INSERT INTO campaigns (name, template_name, locale, audience, variables, status, scheduled_at, tenant_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + campaignColumns
+
+	campaign, err := scanCampaign(r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		campaign.Name,
+		campaign.TemplateName,
+		campaign.Locale,
+		campaign.Audience,
+		campaign.Variables,
+		campaign.Status,
+		campaign.ScheduledAt,
+		tenantID(ctx),
+	))
+	if err != nil {
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return campaign, nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *CampaignsRepository) GetByID(ctx context.Context, id string) (entity.Campaign, error) {
+	const operation = "Repository.CampaignsRepository.GetByID"
+
+	query := `SELECT ` + campaignColumns + ` FROM campaigns WHERE id = $1 AND tenant_id = $2`
+
+	campaign, err := scanCampaign(r.Client.Pool.QueryRow(ctx, query, id, tenantID(ctx)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, erring.ErrCampaignNotFound)
+		}
+
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return campaign, nil
+}
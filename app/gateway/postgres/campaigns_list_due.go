@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// ListDue returns the scheduled campaigns whose time has come and the running
+// ones, which were interrupted before completing, of every tenant.
+func (r *CampaignsRepository) ListDue(ctx context.Context, now time.Time) ([]entity.Campaign, error) {
+	const operation = "Repository.CampaignsRepository.ListDue"
+
+	query := `
+		SELECT ` + campaignColumns + `
+		FROM campaigns
+		WHERE status = 'running'
+			OR (status = 'scheduled' AND scheduled_at <= $1)
+		ORDER BY scheduled_at
+	`
+
+	rows, err := r.Client.Pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	var campaigns []entity.Campaign
+
+	for rows.Next() {
+		campaign, err := scanCampaign(rows)
+		if err != nil {
+			return []entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		campaigns = append(campaigns, campaign)
+	}
+
+	return campaigns, nil
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// UpdateProgress saves the counters owned by the campaign run. The outcome
+// counters are left alone since workers increment them concurrently.
+func (r *CampaignsRepository) UpdateProgress(ctx context.Context, id string, progress entity.CampaignProgress) error {
+	const (
+		operation = "Repository.CampaignsRepository.UpdateProgress"
+		query     = `
+			UPDATE campaigns SET
+				cursor_user_id = NULLIF($2, '')::bigint,
+				target_count = $3,
+				queued_count = $4,
+				deferred_count = $5,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+				AND tenant_id = $6
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		id,
+		progress.CursorUserID,
+		progress.Target,
+		progress.Queued,
+		progress.Deferred,
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
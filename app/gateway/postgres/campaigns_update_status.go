@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// UpdateStatus persists a status transition only if the campaign is still in
+// the from status, so concurrent transitions do not overwrite each other.
+func (r *CampaignsRepository) UpdateStatus(ctx context.Context, campaign entity.Campaign, from types.CampaignStatus) (entity.Campaign, error) {
+	const operation = "Repository.CampaignsRepository.UpdateStatus"
+
+	query := `
+		UPDATE campaigns SET
+			status = $3,
+			scheduled_at = $4,
+			started_at = $5,
+			completed_at = $6,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+			AND tenant_id = $7
+			AND status = $2
+		RETURNING ` + campaignColumns
+
+	updated, err := scanCampaign(r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		campaign.ID,
+		from,
+		campaign.Status,
+		campaign.ScheduledAt,
+		campaign.StartedAt,
+		campaign.CompletedAt,
+		tenantID(ctx),
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Campaign{}, fmt.Errorf("%s (%s -> %s) -> %w", operation, from, campaign.Status, erring.ErrCampaignTransitionInvalid)
+		}
+
+		return entity.Campaign{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,9 @@
+package postgres
+
+type ConversationsRepository struct {
+	*Client
+}
+
+func NewConversationsRepository(client *Client) *ConversationsRepository {
+	return &ConversationsRepository{client}
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// Advance saves the conversation after the bot answered the inbound message,
+// opens a handoff when handoffReason is set and marks the message processed,
+// all in one transaction, so a message processed again after a failure finds
+// none of it done.
+func (r *ConversationsRepository) Advance(ctx context.Context, conversation entity.Conversation, messageID, handoffReason string) error {
+	const (
+		operation   = "Repository.ConversationsRepository.Advance"
+		upsertQuery = `
+			INSERT INTO conversations (user_id, flow_id, current_node, variables, last_activity_at)
+				VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (user_id) DO UPDATE SET
+				flow_id = EXCLUDED.flow_id,
+				current_node = EXCLUDED.current_node,
+				variables = EXCLUDED.variables,
+				last_activity_at = EXCLUDED.last_activity_at,
+				updated_at = CURRENT_TIMESTAMP
+		`
+		handoffQuery = `
+			WITH inserted AS (
+				INSERT INTO handoffs (user_id, reason)
+					VALUES ($1, NULLIF($2, ''))
+				ON CONFLICT (user_id) WHERE status <> 'closed' DO NOTHING
+				RETURNING id
+			)
+			INSERT INTO handoff_events (handoff_id, action)
+				SELECT id, 'opened' FROM inserted
+		`
+		processedQuery = `
+			UPDATE user_messages SET
+				processed_at = CURRENT_TIMESTAMP,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+		`
+	)
+
+	tx, err := r.Client.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	_, err = tx.Exec(
+		ctx,
+		upsertQuery,
+		conversation.UserID,
+		conversation.FlowID,
+		conversation.CurrentNode,
+		conversation.Variables,
+		conversation.LastActivityAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if handoffReason != "" {
+		if _, err := tx.Exec(ctx, handoffQuery, conversation.UserID, handoffReason); err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, processedQuery, messageID); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
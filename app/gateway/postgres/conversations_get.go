@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *ConversationsRepository) GetByUserID(ctx context.Context, userID string) (entity.Conversation, error) {
+	const (
+		operation = "Repository.ConversationsRepository.GetByUserID"
+		query     = `
+			SELECT
+				user_id,
+				flow_id,
+				current_node,
+				variables,
+				last_activity_at
+			FROM conversations
+			WHERE user_id = $1
+		`
+	)
+
+	var conversation entity.Conversation
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		userID,
+	).Scan(
+		&conversation.UserID,
+		&conversation.FlowID,
+		&conversation.CurrentNode,
+		&conversation.Variables,
+		&conversation.LastActivityAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Conversation{}, fmt.Errorf("%s -> %w", operation, erring.ErrConversationNotFound)
+		}
+
+		return entity.Conversation{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return conversation, nil
+}
@@ -0,0 +1,9 @@
+package postgres
+
+type ExportRunsRepository struct {
+	*Client
+}
+
+func NewExportRunsRepository(client *Client) *ExportRunsRepository {
+	return &ExportRunsRepository{client}
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+func (r *ExportRunsRepository) Create(ctx context.Context, run entity.ExportRun) (entity.ExportRun, error) {
+	const (
+		operation = "Repository.ExportRunsRepository.Create"
+		query     = `
+			INSERT INTO export_runs (format, destination, created_from, created_to, user_id, tenant_id)
+				VALUES ($1, $2, $3, $4, NULLIF($5, '')::bigint, $6)
+			RETURNING id, status, started_at
+		`
+	)
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		run.Format,
+		run.Destination,
+		run.CreatedFrom,
+		run.CreatedTo,
+		run.UserID,
+		tenantID(ctx),
+	).Scan(
+		&run.ID,
+		&run.Status,
+		&run.StartedAt,
+	)
+	if err != nil {
+		return entity.ExportRun{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return run, nil
+}
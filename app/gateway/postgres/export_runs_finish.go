@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// Finish records how a running export ended.
+func (r *ExportRunsRepository) Finish(ctx context.Context, run entity.ExportRun) (entity.ExportRun, error) {
+	const (
+		operation = "Repository.ExportRunsRepository.Finish"
+		query     = `
+			UPDATE export_runs SET
+				status = $2,
+				rows_exported = $3,
+				error = NULLIF($4, ''),
+				finished_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+			RETURNING finished_at
+		`
+	)
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		run.ID,
+		run.Status,
+		run.RowsExported,
+		run.Error,
+	).Scan(
+		&run.FinishedAt,
+	)
+	if err != nil {
+		return entity.ExportRun{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return run, nil
+}
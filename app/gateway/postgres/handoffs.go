@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+type HandoffsRepository struct {
+	*Client
+}
+
+func NewHandoffsRepository(client *Client) *HandoffsRepository {
+	return &HandoffsRepository{client}
+}
+
+const handoffColumns = `
+	h.id,
+	h.status,
+	COALESCE(h.agent_id, ''),
+	COALESCE(h.reason, ''),
+	h.opened_at,
+	h.claimed_at,
+	h.closed_at,
+	u.id,
+	u.name,
+	u.phone_number,
+	COALESCE(u.wa_id, ''),
+	u.consent,
+	COALESCE(u.time_zone, ''),
+	u.attributes,
+	u.preferred_channel,
+	u.created_at
+`
+
+func scanHandoff(row pgx.Row) (entity.Handoff, error) {
+	var handoff entity.Handoff
+
+	err := row.Scan(
+		&handoff.ID,
+		&handoff.Status,
+		&handoff.AgentID,
+		&handoff.Reason,
+		&handoff.OpenedAt,
+		&handoff.ClaimedAt,
+		&handoff.ClosedAt,
+		&handoff.User.ID,
+		&handoff.User.Name,
+		&handoff.User.PhoneNumber,
+		&handoff.User.WaID,
+		&handoff.User.Consent,
+		&handoff.User.TimeZone,
+		&handoff.User.Attributes,
+		&handoff.User.PreferredChannel,
+		&handoff.User.CreatedAt,
+	)
+
+	return handoff, err //nolint:wrapcheck
+}
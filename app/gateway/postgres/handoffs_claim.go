@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+// Claim assigns an open handoff to the agent and records it in the audit
+// trail.
+func (r *HandoffsRepository) Claim(ctx context.Context, id, agentID string) error {
+	const (
+		operation = "Repository.HandoffsRepository.Claim"
+		query     = `
+			WITH updated AS (
+				UPDATE handoffs SET
+					status = 'claimed',
+					agent_id = $2,
+					claimed_at = CURRENT_TIMESTAMP
+				WHERE id = $1
+					AND user_id IN (SELECT id FROM users WHERE tenant_id = $3)
+					AND status = 'open'
+				RETURNING id
+			)
+			INSERT INTO handoff_events (handoff_id, action, agent_id)
+				SELECT id, 'claimed', $2 FROM updated
+			RETURNING handoff_id
+		`
+	)
+
+	var handoffID string
+
+	err := r.Client.Pool.QueryRow(ctx, query, id, agentID, tenantID(ctx)).Scan(&handoffID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s -> %w", operation, erring.ErrHandoffTransitionInvalid)
+		}
+
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+func (r *HandoffsRepository) CreateEvent(ctx context.Context, event entity.HandoffEvent) error {
+	const (
+		operation = "Repository.HandoffsRepository.CreateEvent"
+		query     = `
+			INSERT INTO handoff_events (handoff_id, action, agent_id)
+				VALUES ($1, $2, NULLIF($3, ''))
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		event.HandoffID,
+		event.Action,
+		event.AgentID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *HandoffsRepository) GetByID(ctx context.Context, id string) (entity.Handoff, error) {
+	const operation = "Repository.HandoffsRepository.GetByID"
+
+	query := `
+		SELECT ` + handoffColumns + `
+		FROM handoffs h
+			JOIN users u ON u.id = h.user_id
+		WHERE h.id = $1
+			AND u.tenant_id = $2
+	`
+
+	handoff, err := scanHandoff(r.Client.Pool.QueryRow(ctx, query, id, tenantID(ctx)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, erring.ErrHandoffNotFound)
+		}
+
+		return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return handoff, nil
+}
+
+// GetActiveByUserID returns the handoff of the user that is not closed.
+func (r *HandoffsRepository) GetActiveByUserID(ctx context.Context, userID string) (entity.Handoff, error) {
+	const operation = "Repository.HandoffsRepository.GetActiveByUserID"
+
+	query := `
+		SELECT ` + handoffColumns + `
+		FROM handoffs h
+			JOIN users u ON u.id = h.user_id
+		WHERE h.user_id = $1
+			AND u.tenant_id = $2
+			AND h.status <> 'closed'
+	`
+
+	handoff, err := scanHandoff(r.Client.Pool.QueryRow(ctx, query, userID, tenantID(ctx)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, erring.ErrHandoffNotFound)
+		}
+
+		return entity.Handoff{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return handoff, nil
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// List returns the handoffs with the given status, oldest first.
+func (r *HandoffsRepository) List(ctx context.Context, status types.HandoffStatus) ([]entity.Handoff, error) {
+	const operation = "Repository.HandoffsRepository.List"
+
+	query := `
+		SELECT ` + handoffColumns + `
+		FROM handoffs h
+			JOIN users u ON u.id = h.user_id
+		WHERE h.status = $1
+			AND u.tenant_id = $2
+		ORDER BY h.opened_at
+	`
+
+	rows, err := r.Client.Pool.Query(ctx, query, status, tenantID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	handoffs := []entity.Handoff{}
+
+	for rows.Next() {
+		handoff, err := scanHandoff(rows)
+		if err != nil {
+			return []entity.Handoff{}, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		handoffs = append(handoffs, handoff)
+	}
+
+	return handoffs, nil
+}
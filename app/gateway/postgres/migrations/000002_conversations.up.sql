@@ -0,0 +1,15 @@
+begin;
+
+create table if not exists conversations
+(
+    user_id            bigint      primary key references users(id),
+    flow_id            text        not null,
+    current_node       text        not null,
+    variables          jsonb       not null default '{}',
+    last_activity_at   timestamptz not null default current_timestamp,
+
+    created_at         timestamptz not null default current_timestamp,
+    updated_at         timestamptz not null default current_timestamp
+);
+
+commit;
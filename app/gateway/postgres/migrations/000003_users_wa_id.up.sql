@@ -0,0 +1,5 @@
+begin;
+
+alter table users add column if not exists wa_id text;
+
+commit;
@@ -0,0 +1,17 @@
+begin;
+
+create table if not exists user_message_attachments
+(
+    id                bigint      generated always as identity  primary key,
+    user_message_id   bigint      not null references user_messages(id),
+    content_type      text        not null,
+    source_url        text        not null,
+    storage_key       text        not null,
+    size              bigint      not null,
+
+    created_at        timestamptz not null default current_timestamp
+);
+
+create index if not exists user_message_attachments_user_message_id_idx on user_message_attachments (user_message_id);
+
+commit;
@@ -0,0 +1,10 @@
+begin;
+
+alter table user_messages
+    drop column if exists direction,
+    drop column if exists twilio_sid,
+    drop column if exists template_id,
+    drop column if exists content_variables,
+    drop column if exists status;
+
+commit;
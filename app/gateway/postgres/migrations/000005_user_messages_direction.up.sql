@@ -0,0 +1,10 @@
+begin;
+
+alter table user_messages
+    add column if not exists direction           text   not null default 'inbound',
+    add column if not exists twilio_sid          text,
+    add column if not exists template_id         text,
+    add column if not exists content_variables   jsonb,
+    add column if not exists status              text;
+
+commit;
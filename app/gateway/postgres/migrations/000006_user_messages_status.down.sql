@@ -0,0 +1,9 @@
+begin;
+
+drop index if exists user_messages_twilio_sid_idx;
+
+alter table user_messages
+    drop column if exists error_code,
+    drop column if exists updated_at;
+
+commit;
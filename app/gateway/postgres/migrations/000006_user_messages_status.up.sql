@@ -0,0 +1,9 @@
+begin;
+
+alter table user_messages
+    add column if not exists error_code   text,
+    add column if not exists updated_at   timestamptz not null default current_timestamp;
+
+create index if not exists user_messages_twilio_sid_idx on user_messages (twilio_sid);
+
+commit;
@@ -0,0 +1,9 @@
+begin;
+
+drop table if exists user_consent_events cascade;
+
+alter table users
+    drop column if exists consent,
+    drop column if exists consent_updated_at;
+
+commit;
@@ -0,0 +1,20 @@
+begin;
+
+alter table users
+    add column if not exists consent              text        not null default 'opted_in',
+    add column if not exists consent_updated_at   timestamptz;
+
+create table if not exists user_consent_events
+(
+    id             bigint      generated always as identity  primary key,
+    user_id        bigint      not null references users(id),
+    consent        text        not null,
+    source         text        not null,
+    keyword        text,
+
+    created_at     timestamptz not null default current_timestamp
+);
+
+create index if not exists user_consent_events_user_id_idx on user_consent_events (user_id);
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+drop table if exists scheduled_messages cascade;
+
+alter table users drop column if exists time_zone;
+
+commit;
@@ -0,0 +1,24 @@
+begin;
+
+alter table users add column if not exists time_zone text;
+
+update users set time_zone = 'America/Sao_Paulo'
+where time_zone is null
+    and regexp_replace(phone_number, '\D', '', 'g') like '55%';
+
+create table if not exists scheduled_messages
+(
+    id                  bigint      generated always as identity  primary key,
+    user_id             bigint      not null references users(id),
+    template_id         text        not null,
+    content_variables   jsonb,
+    status              text        not null default 'pending',
+    send_at             timestamptz not null,
+    sent_at             timestamptz,
+
+    created_at          timestamptz not null default current_timestamp
+);
+
+create index if not exists scheduled_messages_pending_idx on scheduled_messages (send_at) where status = 'pending';
+
+commit;
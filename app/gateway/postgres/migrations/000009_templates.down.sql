@@ -0,0 +1,12 @@
+begin;
+
+update user_messages set template_name = t.content_sid from templates t where t.name = user_messages.template_name;
+update scheduled_messages set template_name = t.content_sid from templates t where t.name = scheduled_messages.template_name;
+
+alter table user_messages rename column template_name to template_id;
+
+alter table scheduled_messages rename column template_name to template_id;
+
+drop table if exists templates cascade;
+
+commit;
@@ -0,0 +1,31 @@
+begin;
+
+create table if not exists templates
+(
+    id            bigint      generated always as identity  primary key,
+    name          text        not null,
+    locale        text        not null,
+    channel       text        not null,
+    content_sid   text        not null,
+    variables     jsonb       not null default '[]',
+
+    created_at    timestamptz not null default current_timestamp,
+
+    unique (name, locale, channel)
+);
+
+insert into templates (name, locale, channel, content_sid, variables) values
+    ('quick_response', 'pt_BR', 'whatsapp', 'HX1ea028af1b1903fff0f470367d41469c', '[{"name": "1", "required": true}]'),
+    ('list',           'pt_BR', 'whatsapp', 'HXb936d764172f2fdf4c73dcb6bc150631', '[{"name": "1", "required": true}]')
+on conflict do nothing;
+
+alter table user_messages rename column template_id to template_name;
+
+alter table scheduled_messages rename column template_id to template_name;
+
+update user_messages set template_name = 'quick_response' where template_name = 'HX1ea028af1b1903fff0f470367d41469c';
+update user_messages set template_name = 'list' where template_name = 'HXb936d764172f2fdf4c73dcb6bc150631';
+update scheduled_messages set template_name = 'quick_response' where template_name = 'HX1ea028af1b1903fff0f470367d41469c';
+update scheduled_messages set template_name = 'list' where template_name = 'HXb936d764172f2fdf4c73dcb6bc150631';
+
+commit;
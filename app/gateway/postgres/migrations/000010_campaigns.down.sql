@@ -0,0 +1,7 @@
+begin;
+
+alter table scheduled_messages drop column if exists locale;
+
+drop table if exists campaigns cascade;
+
+commit;
@@ -0,0 +1,31 @@
+begin;
+
+create table if not exists campaigns
+(
+    id               bigint      generated always as identity  primary key,
+    name             text        not null,
+    template_name    text        not null,
+    locale           text        not null,
+    audience         jsonb       not null default '{}',
+    variables        jsonb       not null default '{}',
+    status           text        not null default 'draft',
+    scheduled_at     timestamptz,
+    started_at       timestamptz,
+    completed_at     timestamptz,
+
+    cursor_user_id   bigint,
+    target_count     integer     not null default 0,
+    sent_count       integer     not null default 0,
+    deferred_count   integer     not null default 0,
+    skipped_count    integer     not null default 0,
+    failed_count     integer     not null default 0,
+
+    created_at       timestamptz not null default current_timestamp,
+    updated_at       timestamptz not null default current_timestamp
+);
+
+create index if not exists campaigns_due_idx on campaigns (scheduled_at) where status in ('scheduled', 'running');
+
+alter table scheduled_messages add column if not exists locale text;
+
+commit;
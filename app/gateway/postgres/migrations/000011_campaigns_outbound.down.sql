@@ -0,0 +1,5 @@
+begin;
+
+alter table campaigns drop column if exists queued_count;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+alter table campaigns add column if not exists queued_count integer not null default 0;
+
+commit;
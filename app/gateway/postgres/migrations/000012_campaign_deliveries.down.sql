@@ -0,0 +1,5 @@
+begin;
+
+drop table if exists campaign_deliveries cascade;
+
+commit;
@@ -0,0 +1,17 @@
+begin;
+
+create table if not exists campaign_deliveries
+(
+    campaign_id   bigint      not null references campaigns(id),
+    user_id       bigint      not null references users(id),
+    status        text        not null,
+
+    created_at    timestamptz not null default current_timestamp,
+    updated_at    timestamptz not null default current_timestamp,
+
+    primary key (campaign_id, user_id)
+);
+
+create index if not exists campaign_deliveries_status_idx on campaign_deliveries (campaign_id, status);
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+drop table if exists handoff_events cascade;
+
+drop table if exists handoffs cascade;
+
+commit;
@@ -0,0 +1,33 @@
+begin;
+
+create table if not exists handoffs
+(
+    id           bigint      generated always as identity  primary key,
+    user_id      bigint      not null references users(id),
+    status       text        not null default 'open',
+    agent_id     text,
+    reason       text,
+
+    opened_at    timestamptz not null default current_timestamp,
+    claimed_at   timestamptz,
+    closed_at    timestamptz
+);
+
+-- a user has at most one handoff that is not closed
+create unique index if not exists handoffs_active_user_idx on handoffs (user_id) where status <> 'closed';
+
+create index if not exists handoffs_status_idx on handoffs (status, opened_at);
+
+create table if not exists handoff_events
+(
+    id           bigint      generated always as identity  primary key,
+    handoff_id   bigint      not null references handoffs(id),
+    action       text        not null,
+    agent_id     text,
+
+    created_at   timestamptz not null default current_timestamp
+);
+
+create index if not exists handoff_events_handoff_idx on handoff_events (handoff_id);
+
+commit;
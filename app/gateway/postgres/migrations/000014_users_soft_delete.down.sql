@@ -0,0 +1,9 @@
+begin;
+
+drop index if exists users_created_at_idx;
+
+alter table users
+    drop column if exists deleted_at,
+    drop column if exists updated_at;
+
+commit;
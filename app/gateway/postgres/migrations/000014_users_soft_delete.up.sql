@@ -0,0 +1,9 @@
+begin;
+
+alter table users
+    add column if not exists updated_at   timestamptz not null default current_timestamp,
+    add column if not exists deleted_at   timestamptz;
+
+create index if not exists users_created_at_idx on users (created_at) where deleted_at is null;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+drop index if exists user_messages_user_id_created_at_idx;
+
+commit;
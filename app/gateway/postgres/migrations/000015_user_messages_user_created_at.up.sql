@@ -0,0 +1,5 @@
+begin;
+
+create index if not exists user_messages_user_id_created_at_idx on user_messages (user_id, created_at, id);
+
+commit;
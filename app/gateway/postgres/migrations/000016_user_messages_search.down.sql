@@ -0,0 +1,8 @@
+begin;
+
+drop index if exists user_messages_message_search_idx;
+
+alter table user_messages
+    drop column if exists message_search;
+
+commit;
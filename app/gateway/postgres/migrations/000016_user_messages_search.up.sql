@@ -0,0 +1,9 @@
+begin;
+
+alter table user_messages
+    add column if not exists message_search tsvector
+        generated always as (to_tsvector('portuguese', coalesce(message, ''))) stored;
+
+create index if not exists user_messages_message_search_idx on user_messages using gin (message_search);
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+alter table users
+    drop column if exists attributes;
+
+commit;
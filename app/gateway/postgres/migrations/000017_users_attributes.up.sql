@@ -0,0 +1,6 @@
+begin;
+
+alter table users
+    add column if not exists attributes jsonb not null default '{}';
+
+commit;
@@ -0,0 +1,19 @@
+begin;
+
+create table if not exists export_runs
+(
+    id              bigint      generated always as identity  primary key,
+    format          text        not null,
+    destination     text        not null,
+    created_from    timestamptz,
+    created_to      timestamptz,
+    user_id         bigint      references users(id),
+    status          text        not null default 'running',
+    rows_exported   bigint      not null default 0,
+    error           text,
+
+    started_at      timestamptz not null default current_timestamp,
+    finished_at     timestamptz
+);
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+-- The normalised numbers and the rows merged into the kept users cannot be
+-- told apart anymore.
+drop index if exists users_phone_number_key;
+
+commit;
@@ -0,0 +1,132 @@
+begin;
+
+-- Keep only the digits, with a leading +.
+update users
+set phone_number = '+' || regexp_replace(phone_number, '\D', '', 'g')
+where phone_number <> '+' || regexp_replace(phone_number, '\D', '', 'g');
+
+-- Brazilian mobile numbers missing the ninth digit.
+update users
+set phone_number = '+55' || substr(phone_number, 4, 2) || '9' || substr(phone_number, 6)
+where phone_number ~ '^\+55\d{2}[6-9]\d{7}$';
+
+-- Numbers typed in different ways may now be duplicated. The oldest user is
+-- kept and the others are merged into it, then deleted.
+create temporary table user_merges on commit drop as
+select u.id as duplicate_id,
+    min(k.id) as kept_id
+from users u
+join users k on k.phone_number = u.phone_number
+    and k.id < u.id
+    and k.deleted_at is null
+where u.deleted_at is null
+group by u.id;
+
+-- Every user of a merge with the user it is merged into, the kept user
+-- included. The kept user has the lowest id of its group.
+create temporary table user_merge_groups on commit drop as
+select duplicate_id as user_id, kept_id from user_merges
+union
+select kept_id, kept_id from user_merges;
+
+-- The most restrictive consent wins, so an opt-out is never lost.
+update users k
+set consent = 'opted_out',
+    consent_updated_at = d.consent_updated_at,
+    updated_at = current_timestamp
+from (
+    select distinct on (m.kept_id) m.kept_id, d.consent_updated_at
+    from user_merges m
+    join users d on d.id = m.duplicate_id
+    where d.consent = 'opted_out'
+    order by m.kept_id, d.consent_updated_at desc nulls last
+) d
+where k.id = d.kept_id
+    and k.consent <> 'opted_out';
+
+update users k
+set wa_id = coalesce(k.wa_id, d.wa_id),
+    time_zone = coalesce(k.time_zone, d.time_zone),
+    attributes = d.attributes || k.attributes,
+    updated_at = current_timestamp
+from user_merges m
+join users d on d.id = m.duplicate_id
+where k.id = m.kept_id;
+
+update user_messages c set user_id = m.kept_id from user_merges m where c.user_id = m.duplicate_id;
+
+update user_consent_events c set user_id = m.kept_id from user_merges m where c.user_id = m.duplicate_id;
+
+update scheduled_messages c set user_id = m.kept_id from user_merges m where c.user_id = m.duplicate_id;
+
+update export_runs c set user_id = m.kept_id from user_merges m where c.user_id = m.duplicate_id;
+
+-- A user has at most one handoff that is not closed. The one of the lowest
+-- user id of the group stays open, the kept user first.
+update handoffs h
+set status = 'closed',
+    closed_at = current_timestamp
+from user_merge_groups g
+where h.user_id = g.user_id
+    and h.status <> 'closed'
+    and exists (
+        select 1
+        from handoffs a
+        join user_merge_groups ag on ag.user_id = a.user_id
+        where ag.kept_id = g.kept_id
+            and a.status <> 'closed'
+            and a.user_id < h.user_id
+    );
+
+update handoffs c set user_id = m.kept_id from user_merges m where c.user_id = m.duplicate_id;
+
+-- A user has one conversation, the one with the latest activity is kept.
+delete from conversations c
+using user_merge_groups g
+where c.user_id = g.user_id
+    and exists (
+        select 1
+        from conversations o
+        join user_merge_groups og on og.user_id = o.user_id
+        where og.kept_id = g.kept_id
+            and (o.last_activity_at, o.user_id) > (c.last_activity_at, c.user_id)
+    );
+
+update conversations c set user_id = m.kept_id from user_merges m where c.user_id = m.duplicate_id;
+
+-- A user has one delivery per campaign. The row of the lowest user id of the
+-- group is kept, the others stay with the deleted users.
+update campaign_deliveries c
+set user_id = m.kept_id,
+    updated_at = current_timestamp
+from user_merges m
+where c.user_id = m.duplicate_id
+    and not exists (
+        select 1
+        from campaign_deliveries o
+        join user_merge_groups og on og.user_id = o.user_id
+        where og.kept_id = m.kept_id
+            and o.campaign_id = c.campaign_id
+            and o.user_id < c.user_id
+    );
+
+-- The merged users are deleted.
+update users u
+set deleted_at = current_timestamp,
+    updated_at = current_timestamp
+from user_merges m
+where u.id = m.duplicate_id;
+
+-- Campaign audiences match the phone numbers by prefix, in the same form.
+update campaigns
+set audience = jsonb_set(audience, '{phone_prefixes}', (
+        select coalesce(jsonb_agg('+' || regexp_replace(prefix, '\D', '', 'g')), '[]'::jsonb)
+        from jsonb_array_elements_text(audience -> 'phone_prefixes') as prefix
+        where regexp_replace(prefix, '\D', '', 'g') <> ''
+    )),
+    updated_at = current_timestamp
+where jsonb_typeof(audience -> 'phone_prefixes') = 'array';
+
+create unique index if not exists users_phone_number_key on users (phone_number) where deleted_at is null;
+
+commit;
@@ -0,0 +1,9 @@
+begin;
+
+alter table templates
+    drop column if exists body;
+
+alter table users
+    drop column if exists preferred_channel;
+
+commit;
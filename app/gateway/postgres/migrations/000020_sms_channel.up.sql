@@ -0,0 +1,10 @@
+begin;
+
+alter table users
+    add column if not exists preferred_channel text not null default 'whatsapp';
+
+-- Plain-text version of the template, sent on channels without content sids.
+alter table templates
+    add column if not exists body text;
+
+commit;
@@ -0,0 +1,19 @@
+begin;
+
+drop index if exists campaigns_tenant_id_idx;
+
+alter table templates drop constraint if exists templates_tenant_id_name_locale_channel_key;
+alter table templates add constraint templates_name_locale_channel_key unique (name, locale, channel);
+
+drop index if exists users_phone_number_key;
+create unique index if not exists users_phone_number_key on users (phone_number) where deleted_at is null;
+
+alter table export_runs drop column if exists tenant_id;
+alter table campaigns drop column if exists tenant_id;
+alter table templates drop column if exists tenant_id;
+alter table users drop column if exists tenant_id;
+
+drop table if exists tenant_numbers;
+drop table if exists tenants;
+
+commit;
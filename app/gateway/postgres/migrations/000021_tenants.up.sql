@@ -0,0 +1,49 @@
+begin;
+
+create table if not exists tenants
+(
+    id                             bigint      generated by default as identity  primary key,
+    name                           text        not null,
+
+    -- Empty for tenants sending through the Twilio account of the config.
+    twilio_account_sid             text        not null default '',
+    twilio_auth_token              text        not null default '',
+    twilio_messaging_service_sid   text        not null default '',
+    twilio_status_callback_url     text        not null default '',
+    origin_number                  text        not null default '',
+
+    created_at                     timestamptz not null default current_timestamp,
+    updated_at                     timestamptz not null default current_timestamp
+);
+
+create unique index if not exists tenants_origin_number_key on tenants (origin_number) where origin_number <> '';
+
+-- Numbers, besides the origin one, whose inbound messages are routed to the
+-- tenant. Numbers are stored in E.164, as the users ones.
+create table if not exists tenant_numbers
+(
+    phone_number   text        primary key,
+    tenant_id      bigint      not null references tenants(id),
+
+    created_at     timestamptz not null default current_timestamp
+);
+
+-- Existing data belongs to the default tenant.
+insert into tenants (id, name) values (1, 'default') on conflict do nothing;
+
+select setval(pg_get_serial_sequence('tenants', 'id'), greatest((select max(id) from tenants), 1));
+
+alter table users add column if not exists tenant_id bigint not null default 1 references tenants(id);
+alter table templates add column if not exists tenant_id bigint not null default 1 references tenants(id);
+alter table campaigns add column if not exists tenant_id bigint not null default 1 references tenants(id);
+alter table export_runs add column if not exists tenant_id bigint not null default 1 references tenants(id);
+
+drop index if exists users_phone_number_key;
+create unique index if not exists users_phone_number_key on users (tenant_id, phone_number) where deleted_at is null;
+
+alter table templates drop constraint if exists templates_name_locale_channel_key;
+alter table templates add constraint templates_tenant_id_name_locale_channel_key unique (tenant_id, name, locale, channel);
+
+create index if not exists campaigns_tenant_id_idx on campaigns (tenant_id);
+
+commit;
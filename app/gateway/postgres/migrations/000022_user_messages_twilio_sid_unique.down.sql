@@ -0,0 +1,7 @@
+begin;
+
+drop index if exists user_messages_twilio_sid_key;
+
+create index if not exists user_messages_twilio_sid_idx on user_messages (twilio_sid);
+
+commit;
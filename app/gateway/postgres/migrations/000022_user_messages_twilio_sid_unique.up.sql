@@ -0,0 +1,16 @@
+begin;
+
+-- Webhooks retried by Twilio may have stored the same message more than once.
+-- The oldest row keeps the sid, the duplicates are kept without it.
+update user_messages m
+set twilio_sid = null,
+    updated_at = current_timestamp
+from user_messages k
+where k.twilio_sid = m.twilio_sid
+    and k.id < m.id;
+
+drop index if exists user_messages_twilio_sid_idx;
+
+create unique index if not exists user_messages_twilio_sid_key on user_messages (twilio_sid);
+
+commit;
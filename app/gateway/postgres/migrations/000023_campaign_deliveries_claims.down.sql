@@ -0,0 +1,11 @@
+begin;
+
+alter table scheduled_messages drop column if exists campaign_id;
+
+drop index if exists user_messages_campaign_id_user_id_idx;
+
+alter table user_messages drop column if exists campaign_id;
+
+alter table campaign_deliveries drop column if exists claimed_at;
+
+commit;
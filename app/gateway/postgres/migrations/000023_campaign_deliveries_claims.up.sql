@@ -0,0 +1,55 @@
+begin;
+
+-- Claims older than the claim timeout were left by a worker that stopped,
+-- and are taken over.
+alter table campaign_deliveries add column if not exists claimed_at timestamptz;
+
+update campaign_deliveries set claimed_at = updated_at where status = 'sending';
+
+-- The outbound message recorded right after the send tells a taken over
+-- claim the message already left.
+alter table user_messages add column if not exists campaign_id bigint references campaigns(id);
+
+create index if not exists user_messages_campaign_id_user_id_idx on user_messages (campaign_id, user_id) where campaign_id is not null;
+
+-- Deliveries deferred by the quiet hours are settled by their scheduled
+-- message.
+alter table scheduled_messages add column if not exists campaign_id bigint references campaigns(id);
+
+update scheduled_messages sm
+set campaign_id = d.campaign_id
+from campaign_deliveries d
+join campaigns c on c.id = d.campaign_id
+where d.status = 'deferred'
+    and d.user_id = sm.user_id
+    and c.template_name = sm.template_name
+    and sm.campaign_id is null;
+
+-- Deferred deliveries whose scheduled message was already handled.
+with settled as (
+    update campaign_deliveries d
+    set status = case sm.status when 'sent' then 'sent' when 'canceled' then 'skipped' else 'failed' end,
+        updated_at = current_timestamp
+    from scheduled_messages sm
+    where sm.campaign_id = d.campaign_id
+        and sm.user_id = d.user_id
+        and sm.status <> 'pending'
+        and d.status = 'deferred'
+    returning d.campaign_id, d.status
+)
+update campaigns c
+set sent_count = sent_count + s.sent,
+    skipped_count = skipped_count + s.skipped,
+    failed_count = failed_count + s.failed,
+    updated_at = current_timestamp
+from (
+    select campaign_id,
+        count(*) filter (where status = 'sent') as sent,
+        count(*) filter (where status = 'skipped') as skipped,
+        count(*) filter (where status = 'failed') as failed
+    from settled
+    group by campaign_id
+) s
+where c.id = s.campaign_id;
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+drop index if exists user_message_attachments_storage_key_key;
+
+alter table user_messages drop column if exists processed_at;
+
+commit;
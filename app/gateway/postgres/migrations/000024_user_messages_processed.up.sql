@@ -0,0 +1,17 @@
+begin;
+
+-- Inbound messages are marked processed once the bot handled them, so a
+-- webhook delivered again resumes one whose processing was interrupted.
+alter table user_messages add column if not exists processed_at timestamptz;
+
+update user_messages set processed_at = created_at where direction = 'inbound';
+
+-- Attachments stored again when processing resumes keep a single row.
+delete from user_message_attachments a
+using user_message_attachments k
+where k.storage_key = a.storage_key
+    and k.id < a.id;
+
+create unique index if not exists user_message_attachments_storage_key_key on user_message_attachments (storage_key);
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+alter table tenants
+    drop column if exists whatsapp_cloud_access_token,
+    drop column if exists whatsapp_cloud_phone_number_id;
+
+commit;
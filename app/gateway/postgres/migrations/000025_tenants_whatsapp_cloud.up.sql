@@ -0,0 +1,10 @@
+begin;
+
+-- Empty for tenants sending through the WhatsApp Cloud number of the config.
+-- Inbound messages reach the tenant through its numbers, so the display
+-- number of its Cloud number goes in tenant_numbers as well.
+alter table tenants
+    add column if not exists whatsapp_cloud_phone_number_id   text not null default '',
+    add column if not exists whatsapp_cloud_access_token      text not null default '';
+
+commit;
@@ -3,16 +3,20 @@ package postgres
 import (
 	"context"
 	"embed"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/golang-migrate/migrate/v4/source/httpfs"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 
 	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/domain/entity"
 )
 
 //go:embed migrations
@@ -72,3 +76,21 @@ func New(ctx context.Context, config config.Postgres) (*Client, error) {
 
 	return &Client{pool}, nil
 }
+
+// isUniqueViolation tells whether the error comes from a unique constraint.
+func isUniqueViolation(err error) bool {
+	const uniqueViolationCode = "23505"
+
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+// tenantID returns the tenant the queries of the context are scoped to.
+func tenantID(ctx context.Context) string {
+	return entity.TenantIDFromContext(ctx)
+}
+
+// likeEscaper escapes the LIKE wildcards of user input, matched with
+// ESCAPE '\'.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
@@ -0,0 +1,9 @@
+package postgres
+
+type ScheduledMessagesRepository struct {
+	*Client
+}
+
+func NewScheduledMessagesRepository(client *Client) *ScheduledMessagesRepository {
+	return &ScheduledMessagesRepository{client}
+}
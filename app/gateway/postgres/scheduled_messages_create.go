@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+func (r *ScheduledMessagesRepository) Create(ctx context.Context, message entity.ScheduledMessage) error {
+	const (
+		operation = "Repository.ScheduledMessagesRepository.Create"
+		query     = `
+			INSERT INTO scheduled_messages (user_id, template_name, locale, content_variables, send_at, campaign_id)
+				VALUES ($1, $2, NULLIF($3, ''), $4, $5, NULLIF($6, '')::bigint)
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		message.UserID,
+		message.TemplateName,
+		message.Locale,
+		message.ContentVariables,
+		message.SendAt,
+		message.CampaignID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
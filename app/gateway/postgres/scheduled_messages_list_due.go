@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+func (r *ScheduledMessagesRepository) ListDue(ctx context.Context, now time.Time) ([]entity.ScheduledMessage, error) {
+	const (
+		operation = "Repository.ScheduledMessagesRepository.ListDue"
+		query     = `
+			SELECT
+				sm.id,
+				u.tenant_id::text,
+				sm.user_id,
+				sm.template_name,
+				COALESCE(sm.locale, ''),
+				sm.content_variables,
+				sm.status,
+				sm.send_at,
+				sm.sent_at,
+				COALESCE(sm.campaign_id::text, ''),
+				sm.created_at
+			FROM scheduled_messages sm
+				JOIN users u ON u.id = sm.user_id
+			WHERE sm.status = 'pending'
+				AND sm.send_at <= $1
+			ORDER BY sm.send_at
+		`
+	)
+
+	rows, err := r.Client.Pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	var messages []entity.ScheduledMessage
+
+	for rows.Next() {
+		var message entity.ScheduledMessage
+
+		if err := rows.Scan(
+			&message.ID,
+			&message.TenantID,
+			&message.UserID,
+			&message.TemplateName,
+			&message.Locale,
+			&message.ContentVariables,
+			&message.Status,
+			&message.SendAt,
+			&message.SentAt,
+			&message.CampaignID,
+			&message.CreatedAt,
+		); err != nil {
+			return []entity.ScheduledMessage{}, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Reschedule keeps a pending message for a later send.
+func (r *ScheduledMessagesRepository) Reschedule(ctx context.Context, id string, sendAt time.Time) error {
+	const (
+		operation = "Repository.ScheduledMessagesRepository.Reschedule"
+		query     = `
+			UPDATE scheduled_messages SET
+				send_at = $2
+			WHERE id = $1
+				AND status = 'pending'
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		id,
+		sendAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
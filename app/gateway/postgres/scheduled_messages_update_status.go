@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+// UpdateStatus records the final status of the message. A message deferred
+// from a campaign settles its delivery in the same statement, counted in the
+// campaign progress like the ones sent by the workers.
+func (r *ScheduledMessagesRepository) UpdateStatus(ctx context.Context, id string, status types.ScheduledMessageStatus) error {
+	const (
+		operation = "Repository.ScheduledMessagesRepository.UpdateStatus"
+		query     = `
+			WITH updated AS (
+				UPDATE scheduled_messages SET
+					status = $2,
+					sent_at = CASE WHEN $2 = 'sent' THEN CURRENT_TIMESTAMP ELSE sent_at END
+				WHERE id = $1
+				RETURNING campaign_id, user_id
+			), delivered AS (
+				UPDATE campaign_deliveries d SET
+					status = CASE $2 WHEN 'sent' THEN 'sent' WHEN 'canceled' THEN 'skipped' ELSE 'failed' END,
+					updated_at = CURRENT_TIMESTAMP
+				FROM updated
+				WHERE d.campaign_id = updated.campaign_id
+					AND d.user_id = updated.user_id
+					AND d.status = 'deferred'
+				RETURNING d.campaign_id, d.status
+			)
+			UPDATE campaigns SET
+				sent_count = sent_count + CASE WHEN delivered.status = 'sent' THEN 1 ELSE 0 END,
+				skipped_count = skipped_count + CASE WHEN delivered.status = 'skipped' THEN 1 ELSE 0 END,
+				failed_count = failed_count + CASE WHEN delivered.status = 'failed' THEN 1 ELSE 0 END,
+				updated_at = CURRENT_TIMESTAMP
+			FROM delivered
+			WHERE campaigns.id = delivered.campaign_id
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		id,
+		status,
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
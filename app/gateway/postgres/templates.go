@@ -0,0 +1,9 @@
+package postgres
+
+type TemplatesRepository struct {
+	*Client
+}
+
+func NewTemplatesRepository(client *Client) *TemplatesRepository {
+	return &TemplatesRepository{client}
+}
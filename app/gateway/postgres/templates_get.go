@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *TemplatesRepository) Get(ctx context.Context, name, locale, channel string) (entity.Template, error) {
+	const (
+		operation = "Repository.TemplatesRepository.Get"
+		query     = `
+			SELECT
+				id,
+				name,
+				locale,
+				channel,
+				content_sid,
+				COALESCE(body, ''),
+				variables,
+				created_at
+			FROM templates
+			WHERE name = $1
+				AND locale = $2
+				AND channel = $3
+				AND tenant_id = $4
+		`
+	)
+
+	var template entity.Template
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		name,
+		locale,
+		channel,
+		tenantID(ctx),
+	).Scan(
+		&template.ID,
+		&template.Name,
+		&template.Locale,
+		&template.Channel,
+		&template.ContentSID,
+		&template.Body,
+		&template.Variables,
+		&template.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Template{}, fmt.Errorf("%s (%s/%s/%s) -> %w", operation, name, locale, channel, erring.ErrTemplateNotFound)
+		}
+
+		return entity.Template{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return template, nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// List returns the templates of every tenant, each with its tenant id.
+func (r *TemplatesRepository) List(ctx context.Context) ([]entity.Template, error) {
+	const (
+		operation = "Repository.TemplatesRepository.List"
+		query     = `
+			SELECT
+				id,
+				tenant_id,
+				name,
+				locale,
+				channel,
+				content_sid,
+				COALESCE(body, ''),
+				variables,
+				created_at
+			FROM templates
+		`
+	)
+
+	rows, err := r.Client.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	var templates []entity.Template
+
+	for rows.Next() {
+		var template entity.Template
+
+		if err := rows.Scan(
+			&template.ID,
+			&template.TenantID,
+			&template.Name,
+			&template.Locale,
+			&template.Channel,
+			&template.ContentSID,
+			&template.Body,
+			&template.Variables,
+			&template.CreatedAt,
+		); err != nil {
+			return []entity.Template{}, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+type TenantsRepository struct {
+	*Client
+}
+
+func NewTenantsRepository(client *Client) *TenantsRepository {
+	return &TenantsRepository{client}
+}
+
+const tenantColumns = `
+	t.id,
+	t.name,
+	t.twilio_account_sid,
+	t.twilio_auth_token,
+	t.twilio_messaging_service_sid,
+	t.twilio_status_callback_url,
+	t.origin_number,
+	t.whatsapp_cloud_phone_number_id,
+	t.whatsapp_cloud_access_token,
+	t.created_at,
+	t.updated_at
+`
+
+func scanTenant(row pgx.Row) (entity.Tenant, error) {
+	var tenant entity.Tenant
+
+	err := row.Scan(
+		&tenant.ID,
+		&tenant.Name,
+		&tenant.TwilioAccountSID,
+		&tenant.TwilioAuthToken,
+		&tenant.TwilioMessagingServiceSid,
+		&tenant.TwilioStatusCallbackURL,
+		&tenant.OriginNumber,
+		&tenant.WhatsappCloudPhoneNumberID,
+		&tenant.WhatsappCloudAccessToken,
+		&tenant.CreatedAt,
+		&tenant.UpdatedAt,
+	)
+
+	return tenant, err //nolint:wrapcheck
+}
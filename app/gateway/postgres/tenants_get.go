@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *TenantsRepository) GetByID(ctx context.Context, id string) (entity.Tenant, error) {
+	const operation = "Repository.TenantsRepository.GetByID"
+
+	query := `SELECT ` + tenantColumns + ` FROM tenants t WHERE t.id = $1`
+
+	tenant, err := scanTenant(r.Client.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Tenant{}, fmt.Errorf("%s (%s) -> %w", operation, id, erring.ErrTenantNotFound)
+		}
+
+		return entity.Tenant{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return tenant, nil
+}
+
+// GetByNumber returns the tenant that sends from the number or has it among
+// its other numbers.
+func (r *TenantsRepository) GetByNumber(ctx context.Context, phoneNumber string) (entity.Tenant, error) {
+	const operation = "Repository.TenantsRepository.GetByNumber"
+
+	query := `
+		SELECT ` + tenantColumns + `
+		FROM tenants t
+		WHERE t.origin_number = $1
+			OR t.id = (SELECT tenant_id FROM tenant_numbers WHERE phone_number = $1)
+		LIMIT 1
+	`
+
+	tenant, err := scanTenant(r.Client.Pool.QueryRow(ctx, query, phoneNumber))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Tenant{}, fmt.Errorf("%s (%s) -> %w", operation, phoneNumber, erring.ErrTenantNotFound)
+		}
+
+		return entity.Tenant{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return tenant, nil
+}
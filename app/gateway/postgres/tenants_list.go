@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+func (r *TenantsRepository) List(ctx context.Context) ([]entity.Tenant, error) {
+	const operation = "Repository.TenantsRepository.List"
+
+	query := `SELECT ` + tenantColumns + ` FROM tenants t ORDER BY t.id`
+
+	rows, err := r.Client.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	var tenants []entity.Tenant
+
+	for rows.Next() {
+		tenant, err := scanTenant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		tenants = append(tenants, tenant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return tenants, nil
+}
@@ -0,0 +1,9 @@
+package postgres
+
+type UserMessageAttachmentsRepository struct {
+	*Client
+}
+
+func NewUserMessageAttachmentsRepository(client *Client) *UserMessageAttachmentsRepository {
+	return &UserMessageAttachmentsRepository{client}
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// Create stores the attachment once; the same storage key stored again when
+// the message is processed anew is ignored.
+func (r *UserMessageAttachmentsRepository) Create(ctx context.Context, attachment entity.UserMessageAttachment) error {
+	const (
+		operation = "Repository.UserMessageAttachmentsRepository.Create"
+		query     = `
+			INSERT INTO user_message_attachments (user_message_id, content_type, source_url, storage_key, size)
+				VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (storage_key) DO NOTHING
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		attachment.UserMessageID,
+		attachment.ContentType,
+		attachment.SourceURL,
+		attachment.StorageKey,
+		attachment.Size,
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -2,30 +2,48 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+
 	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
 )
 
-func (r *UserMessagesRepository) Create(ctx context.Context, message entity.UserMessage) error {
+func (r *UserMessagesRepository) Create(ctx context.Context, message entity.UserMessage) (entity.UserMessage, error) {
 	const (
 		operation = "Repository.UserMessagesRepository.Create"
 		query     = `
-			INSERT INTO user_messages (user_id, message)
-				VALUES ($1, $2)
+			INSERT INTO user_messages (user_id, direction, message, twilio_sid, template_name, content_variables, status, campaign_id)
+				VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), $6, NULLIF($7, ''), NULLIF($8, '')::bigint)
 			ON CONFLICT DO NOTHING
+			RETURNING id, created_at
 		`
 	)
 
-	_, err := r.Client.Pool.Exec(
+	err := r.Client.Pool.QueryRow(
 		ctx,
 		query,
 		message.UserID,
+		message.Direction,
 		message.Message,
+		message.TwilioSID,
+		message.TemplateName,
+		message.ContentVariables,
+		message.Status,
+		message.CampaignID,
+	).Scan(
+		&message.ID,
+		&message.CreatedAt,
 	)
 	if err != nil {
-		return fmt.Errorf("%s -> %w", operation, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.UserMessage{}, fmt.Errorf("%s -> %w", operation, erring.ErrUserMessageAlreadyExists)
+		}
+
+		return entity.UserMessage{}, fmt.Errorf("%s -> %w", operation, err)
 	}
 
-	return nil
+	return message, nil
 }
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExistsByCampaign tells whether the campaign message to the user was sent,
+// as recorded right after the send.
+func (r *UserMessagesRepository) ExistsByCampaign(ctx context.Context, campaignID, userID string) (bool, error) {
+	const (
+		operation = "Repository.UserMessagesRepository.ExistsByCampaign"
+		query     = `
+			SELECT EXISTS (
+				SELECT 1
+				FROM user_messages
+				WHERE campaign_id = $1
+					AND user_id = $2
+					AND direction = 'outbound'
+			)
+		`
+	)
+
+	var exists bool
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		campaignID,
+		userID,
+	).Scan(
+		&exists,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExistsInbound tells whether the user sent any message other than the one
+// with the given sid.
+func (r *UserMessagesRepository) ExistsInbound(ctx context.Context, userID, exceptTwilioSID string) (bool, error) {
+	const (
+		operation = "Repository.UserMessagesRepository.ExistsInbound"
+		query     = `
+			SELECT EXISTS (
+				SELECT 1
+				FROM user_messages
+				WHERE user_id = $1
+					AND direction = 'inbound'
+					AND twilio_sid IS DISTINCT FROM $2
+					AND EXISTS (SELECT 1 FROM users u WHERE u.id = user_id AND u.tenant_id = $3)
+			)
+		`
+	)
+
+	var exists bool
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		userID,
+		exceptTwilioSID,
+		tenantID(ctx),
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// Export streams the messages matching the filter with their user, oldest
+// first, calling fn for each row as it is read so exports of any size use
+// constant memory. Soft-deleted users are included, exports being audits.
+func (r *UserMessagesRepository) Export(
+	ctx context.Context,
+	filter dto.UserMessagesExport,
+	fn func(message entity.UserMessage, user entity.User) error,
+) error {
+	const operation = "Repository.UserMessagesRepository.Export"
+
+	args := []any{tenantID(ctx)}
+	conditions := []string{"u.tenant_id = $1"}
+
+	if filter.CreatedFrom != nil {
+		args = append(args, *filter.CreatedFrom)
+		conditions = append(conditions, "um.created_at >= $"+strconv.Itoa(len(args)))
+	}
+
+	if filter.CreatedTo != nil {
+		args = append(args, *filter.CreatedTo)
+		conditions = append(conditions, "um.created_at < $"+strconv.Itoa(len(args)))
+	}
+
+	if filter.UserID != "" {
+		args = append(args, filter.UserID)
+		conditions = append(conditions, "um.user_id = $"+strconv.Itoa(len(args)))
+	}
+
+	query := `
+		SELECT
+			um.id,
+			um.direction,
+			COALESCE(um.message, ''),
+			COALESCE(um.twilio_sid, ''),
+			COALESCE(um.template_name, ''),
+			COALESCE(um.status, ''),
+			COALESCE(um.error_code, ''),
+			um.created_at,
+			u.id,
+			u.name,
+			u.phone_number
+		FROM user_messages um
+		JOIN users u ON u.id = um.user_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY um.created_at, um.id`
+
+	rows, err := r.Client.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			message entity.UserMessage
+			user    entity.User
+		)
+
+		if err := rows.Scan(
+			&message.ID,
+			&message.Direction,
+			&message.Message,
+			&message.TwilioSID,
+			&message.TemplateName,
+			&message.Status,
+			&message.ErrorCode,
+			&message.CreatedAt,
+			&user.ID,
+			&user.Name,
+			&user.PhoneNumber,
+		); err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		message.UserID = user.ID
+
+		if err := fn(message, user); err != nil {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *UserMessagesRepository) GetByTwilioSID(ctx context.Context, twilioSID string) (entity.UserMessage, error) {
+	const (
+		operation = "Repository.UserMessagesRepository.GetByTwilioSID"
+		query     = `
+			SELECT
+				id,
+				user_id,
+				direction,
+				COALESCE(message, ''),
+				twilio_sid,
+				processed_at,
+				created_at
+			FROM user_messages
+			WHERE twilio_sid = $1
+				AND EXISTS (SELECT 1 FROM users u WHERE u.id = user_id AND u.tenant_id = $2)
+		`
+	)
+
+	var message entity.UserMessage
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		twilioSID,
+		tenantID(ctx),
+	).Scan(
+		&message.ID,
+		&message.UserID,
+		&message.Direction,
+		&message.Message,
+		&message.TwilioSID,
+		&message.ProcessedAt,
+		&message.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.UserMessage{}, fmt.Errorf("%s -> %w", operation, erring.ErrUserMessageNotFound)
+		}
+
+		return entity.UserMessage{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return message, nil
+}
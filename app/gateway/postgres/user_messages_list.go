@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// List returns a page of the messages of a user, both inbound and outbound,
+// oldest first.
+func (r *UserMessagesRepository) List(ctx context.Context, filter dto.UserMessagesFilter) ([]entity.UserMessage, error) {
+	const operation = "Repository.UserMessagesRepository.List"
+
+	args := []any{filter.UserID, tenantID(ctx)}
+	conditions := []string{"user_id = $1", "EXISTS (SELECT 1 FROM users u WHERE u.id = user_id AND u.tenant_id = $2)"}
+
+	if filter.CreatedFrom != nil {
+		args = append(args, *filter.CreatedFrom)
+		conditions = append(conditions, "created_at >= $"+strconv.Itoa(len(args)))
+	}
+
+	if filter.CreatedTo != nil {
+		args = append(args, *filter.CreatedTo)
+		conditions = append(conditions, "created_at < $"+strconv.Itoa(len(args)))
+	}
+
+	if filter.AfterID != "" {
+		args = append(args, filter.AfterCreatedAt, filter.AfterID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) > ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, filter.Limit)
+
+	query := `
+		SELECT
+			id,
+			user_id,
+			direction,
+			COALESCE(message, ''),
+			COALESCE(twilio_sid, ''),
+			COALESCE(template_name, ''),
+			content_variables,
+			COALESCE(status, ''),
+			COALESCE(error_code, ''),
+			created_at
+		FROM user_messages
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY created_at, id
+		LIMIT $` + strconv.Itoa(len(args))
+
+	rows, err := r.Client.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	messages := []entity.UserMessage{}
+
+	for rows.Next() {
+		var message entity.UserMessage
+
+		if err := rows.Scan(
+			&message.ID,
+			&message.UserID,
+			&message.Direction,
+			&message.Message,
+			&message.TwilioSID,
+			&message.TemplateName,
+			&message.ContentVariables,
+			&message.Status,
+			&message.ErrorCode,
+			&message.CreatedAt,
+		); err != nil {
+			return []entity.UserMessage{}, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// ListByUserID returns the last limit messages of the user, oldest first.
+func (r *UserMessagesRepository) ListByUserID(ctx context.Context, userID string, limit int) ([]entity.UserMessage, error) {
+	const (
+		operation = "Repository.UserMessagesRepository.ListByUserID"
+		query     = `
+			SELECT * FROM (
+				SELECT
+					id,
+					user_id,
+					direction,
+					COALESCE(message, ''),
+					COALESCE(twilio_sid, ''),
+					COALESCE(template_name, ''),
+					content_variables,
+					COALESCE(status, ''),
+					COALESCE(error_code, ''),
+					created_at
+				FROM user_messages
+				WHERE user_id = $1
+				ORDER BY created_at DESC, id DESC
+				LIMIT $2
+			) AS latest
+			ORDER BY created_at, id
+		`
+	)
+
+	rows, err := r.Client.Pool.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	messages := []entity.UserMessage{}
+
+	for rows.Next() {
+		var message entity.UserMessage
+
+		if err := rows.Scan(
+			&message.ID,
+			&message.UserID,
+			&message.Direction,
+			&message.Message,
+			&message.TwilioSID,
+			&message.TemplateName,
+			&message.ContentVariables,
+			&message.Status,
+			&message.ErrorCode,
+			&message.CreatedAt,
+		); err != nil {
+			return []entity.UserMessage{}, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// MarkProcessed records that the bot handled the inbound message, so it is
+// not processed again when the webhook is delivered twice.
+func (r *UserMessagesRepository) MarkProcessed(ctx context.Context, id string) error {
+	const (
+		operation = "Repository.UserMessagesRepository.MarkProcessed"
+		query     = `
+			UPDATE user_messages SET
+				processed_at = CURRENT_TIMESTAMP,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// headlineOptions wraps the matched words in <mark> tags, keeping up to two
+// short fragments of long messages.
+const headlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2"
+
+// headlineMessage escapes the HTML of the message before it is highlighted,
+// so the <mark> tags are the only markup of the headline. The ampersand goes
+// first so the other entities are not escaped twice.
+const headlineMessage = `replace(replace(replace(replace(replace(COALESCE(um.message, ''),
+	'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+
+// Search returns a page of the messages matching the query, newest first,
+// using the message_search column and its GIN index.
+func (r *UserMessagesRepository) Search(ctx context.Context, search dto.UserMessagesSearch) ([]entity.UserMessageMatch, error) {
+	const operation = "Repository.UserMessagesRepository.Search"
+
+	args := []any{search.Query, tenantID(ctx)}
+	conditions := []string{"um.message_search @@ q.query", "u.tenant_id = $2", "u.deleted_at IS NULL"}
+
+	if search.CreatedFrom != nil {
+		args = append(args, *search.CreatedFrom)
+		conditions = append(conditions, "um.created_at >= $"+strconv.Itoa(len(args)))
+	}
+
+	if search.CreatedTo != nil {
+		args = append(args, *search.CreatedTo)
+		conditions = append(conditions, "um.created_at < $"+strconv.Itoa(len(args)))
+	}
+
+	if search.BeforeID != "" {
+		args = append(args, search.BeforeCreatedAt, search.BeforeID)
+		conditions = append(conditions, fmt.Sprintf("(um.created_at, um.id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, search.Limit)
+
+	query := `
+		SELECT
+			um.id,
+			um.user_id,
+			um.direction,
+			COALESCE(um.message, ''),
+			COALESCE(um.template_name, ''),
+			COALESCE(um.status, ''),
+			um.created_at,
+			u.name,
+			u.phone_number,
+			ts_headline('portuguese', ` + headlineMessage + `, q.query, '` + headlineOptions + `')
+		FROM user_messages um
+		JOIN users u ON u.id = um.user_id
+		CROSS JOIN websearch_to_tsquery('portuguese', $1) AS q(query)
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY um.created_at DESC, um.id DESC
+		LIMIT $` + strconv.Itoa(len(args))
+
+	rows, err := r.Client.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	matches := []entity.UserMessageMatch{}
+
+	for rows.Next() {
+		var match entity.UserMessageMatch
+
+		if err := rows.Scan(
+			&match.Message.ID,
+			&match.Message.UserID,
+			&match.Message.Direction,
+			&match.Message.Message,
+			&match.Message.TemplateName,
+			&match.Message.Status,
+			&match.Message.CreatedAt,
+			&match.User.Name,
+			&match.User.PhoneNumber,
+			&match.Headline,
+		); err != nil {
+			return []entity.UserMessageMatch{}, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		match.User.ID = match.Message.UserID
+
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/types"
+)
+
+func (r *UserMessagesRepository) UpdateStatus(ctx context.Context, twilioSID string, status types.MessageStatus, errorCode string) error {
+	const (
+		operation = "Repository.UserMessagesRepository.UpdateStatus"
+		query     = `
+			UPDATE user_messages SET
+				status = $2,
+				error_code = NULLIF($3, ''),
+				updated_at = CURRENT_TIMESTAMP
+			WHERE twilio_sid = $1
+				AND direction = 'outbound'
+				AND EXISTS (SELECT 1 FROM users u WHERE u.id = user_id AND u.tenant_id = $5)
+				AND (
+					CASE status
+						WHEN 'queued' THEN 1
+						WHEN 'sent' THEN 2
+						WHEN 'delivered' THEN 3
+						WHEN 'read' THEN 4
+						WHEN 'failed' THEN 5
+						WHEN 'undelivered' THEN 5
+						ELSE 0
+					END
+				) <= $4
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		twilioSID,
+		status,
+		errorCode,
+		status.Rank(),
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
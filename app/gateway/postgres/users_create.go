@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *UsersRepository) Create(ctx context.Context, user entity.User) (entity.User, error) {
+	const (
+		operation = "Repository.UsersRepository.Create"
+		query     = `
+			INSERT INTO users (name, phone_number, wa_id, time_zone, attributes, preferred_channel, tenant_id)
+				VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), COALESCE($5, '{}'), COALESCE(NULLIF($6, ''), 'whatsapp'), $7)
+			RETURNING id, consent, preferred_channel, created_at
+		`
+	)
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		user.Name,
+		user.PhoneNumber,
+		user.WaID,
+		user.TimeZone,
+		user.Attributes,
+		user.PreferredChannel,
+		tenantID(ctx),
+	).Scan(
+		&user.ID,
+		&user.Consent,
+		&user.PreferredChannel,
+		&user.CreatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entity.User{}, fmt.Errorf("%s -> %w", operation, erring.ErrUserAlreadyExists)
+		}
+
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return user, nil
+}
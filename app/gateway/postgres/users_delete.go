@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+// Delete soft-deletes the user, which is then left out of lookups, listings
+// and campaign audiences while its history is kept.
+func (r *UsersRepository) Delete(ctx context.Context, id string) error {
+	const (
+		operation = "Repository.UsersRepository.Delete"
+		query     = `
+			UPDATE users SET
+				deleted_at = CURRENT_TIMESTAMP,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+				AND tenant_id = $2
+				AND deleted_at IS NULL
+		`
+	)
+
+	tag, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		id,
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s -> %w", operation, erring.ErrUserNotFound)
+	}
+
+	return nil
+}
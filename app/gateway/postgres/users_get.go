@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5"
 
 	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
 )
 
 func (r *UsersRepository) GetByPhoneNumber(ctx context.Context, phoneNumber string) (entity.User, error) {
@@ -17,9 +18,17 @@ func (r *UsersRepository) GetByPhoneNumber(ctx context.Context, phoneNumber stri
 			SELECT
 				id,
 				name,
+				phone_number,
+				COALESCE(wa_id, ''),
+				consent,
+				COALESCE(time_zone, ''),
+				attributes,
+				preferred_channel,
 				created_at
 			FROM users
 			WHERE phone_number = $1
+				AND tenant_id = $2
+				AND deleted_at IS NULL
 		`
 	)
 
@@ -29,14 +38,21 @@ func (r *UsersRepository) GetByPhoneNumber(ctx context.Context, phoneNumber stri
 		ctx,
 		query,
 		phoneNumber,
+		tenantID(ctx),
 	).Scan(
 		&user.ID,
 		&user.Name,
+		&user.PhoneNumber,
+		&user.WaID,
+		&user.Consent,
+		&user.TimeZone,
+		&user.Attributes,
+		&user.PreferredChannel,
 		&user.CreatedAt,
 	)
 	if err != nil {
-		if errors.Is(pgx.ErrNoRows, err) {
-			return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.User{}, fmt.Errorf("%s -> %w", operation, erring.ErrUserNotFound)
 		}
 
 		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *UsersRepository) GetByID(ctx context.Context, id string) (entity.User, error) {
+	const (
+		operation = "Repository.UsersRepository.GetByID"
+		query     = `
+			SELECT
+				id,
+				name,
+				phone_number,
+				COALESCE(wa_id, ''),
+				consent,
+				COALESCE(time_zone, ''),
+				attributes,
+				preferred_channel,
+				created_at
+			FROM users
+			WHERE id = $1
+				AND tenant_id = $2
+				AND deleted_at IS NULL
+		`
+	)
+
+	var user entity.User
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		id,
+		tenantID(ctx),
+	).Scan(
+		&user.ID,
+		&user.Name,
+		&user.PhoneNumber,
+		&user.WaID,
+		&user.Consent,
+		&user.TimeZone,
+		&user.Attributes,
+		&user.PreferredChannel,
+		&user.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.User{}, fmt.Errorf("%s -> %w", operation, erring.ErrUserNotFound)
+		}
+
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/dto"
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// Import upserts a batch of users by phone number. The batch is copied into a
+// temporary table, then existing users get the new name and merged attributes
+// while the others are inserted. Nothing is written on a dry run, but the
+// counts are the ones the import would have.
+func (r *UsersRepository) Import(ctx context.Context, users []entity.User, dryRun bool) (dto.UsersImportResult, error) {
+	const (
+		operation   = "Repository.UsersRepository.Import"
+		createQuery = `
+			CREATE TEMPORARY TABLE users_import (
+				name         text,
+				phone_number text,
+				time_zone    text,
+				attributes   jsonb
+			) ON COMMIT DROP
+		`
+		updateQuery = `
+			UPDATE users u SET
+				name = i.name,
+				attributes = u.attributes || i.attributes,
+				updated_at = CURRENT_TIMESTAMP
+			FROM users_import i
+			WHERE u.phone_number = i.phone_number
+				AND u.tenant_id = $1
+				AND u.deleted_at IS NULL
+		`
+		insertQuery = `
+			INSERT INTO users (name, phone_number, time_zone, attributes, tenant_id)
+				SELECT i.name, i.phone_number, NULLIF(i.time_zone, ''), i.attributes, $1
+				FROM users_import i
+				WHERE NOT EXISTS (
+					SELECT 1 FROM users u
+					WHERE u.phone_number = i.phone_number
+						AND u.tenant_id = $1
+						AND u.deleted_at IS NULL
+				)
+		`
+	)
+
+	tx, err := r.Client.Pool.Begin(ctx)
+	if err != nil {
+		return dto.UsersImportResult{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if _, err := tx.Exec(ctx, createQuery); err != nil {
+		return dto.UsersImportResult{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	rows := make([][]any, 0, len(users))
+	for _, user := range users {
+		attributes := user.Attributes
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+
+		rows = append(rows, []any{user.Name, user.PhoneNumber, user.TimeZone, attributes})
+	}
+
+	_, err = tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"users_import"},
+		[]string{"name", "phone_number", "time_zone", "attributes"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return dto.UsersImportResult{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	updated, err := tx.Exec(ctx, updateQuery, tenantID(ctx))
+	if err != nil {
+		return dto.UsersImportResult{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	inserted, err := tx.Exec(ctx, insertQuery, tenantID(ctx))
+	if err != nil {
+		return dto.UsersImportResult{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	result := dto.UsersImportResult{
+		Inserted: int(inserted.RowsAffected()),
+		Updated:  int(updated.RowsAffected()),
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return dto.UsersImportResult{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return result, nil
+}
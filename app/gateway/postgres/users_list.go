@@ -3,30 +3,70 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/chatbot-go/app/domain/dto"
 	"github.com/chatbot-go/app/domain/entity"
 )
 
-func (r *UsersRepository) List(ctx context.Context) ([]entity.User, error) {
-	const (
-		operation = "Repository.UsersRepository.List"
-		query     = `
-			SELECT
-				id,
-				name,
-				phone_number,
-				created_at
-			FROM users
-		`
-	)
-
-	rows, err := r.Client.Pool.Query(ctx, query)
+// List returns a page of the users matching the filter, in id order.
+func (r *UsersRepository) List(ctx context.Context, filter dto.UsersFilter) ([]entity.User, error) {
+	const operation = "Repository.UsersRepository.List"
+
+	args := []any{tenantID(ctx)}
+	conditions := []string{"tenant_id = $1", "deleted_at IS NULL"}
+
+	if filter.Name != "" {
+		args = append(args, likeEscaper.Replace(filter.Name))
+		conditions = append(conditions, `name ILIKE '%' || $`+strconv.Itoa(len(args))+` || '%' ESCAPE '\'`)
+	}
+
+	if filter.PhoneNumber != "" {
+		args = append(args, filter.PhoneNumber)
+		conditions = append(conditions, "phone_number LIKE $"+strconv.Itoa(len(args))+" || '%'")
+	}
+
+	if filter.CreatedFrom != nil {
+		args = append(args, *filter.CreatedFrom)
+		conditions = append(conditions, "created_at >= $"+strconv.Itoa(len(args)))
+	}
+
+	if filter.CreatedTo != nil {
+		args = append(args, *filter.CreatedTo)
+		conditions = append(conditions, "created_at < $"+strconv.Itoa(len(args)))
+	}
+
+	if filter.AfterID != "" {
+		args = append(args, filter.AfterID)
+		conditions = append(conditions, "id > $"+strconv.Itoa(len(args)))
+	}
+
+	args = append(args, filter.Limit)
+
+	query := `
+		SELECT
+			id,
+			name,
+			phone_number,
+			COALESCE(wa_id, ''),
+			consent,
+			COALESCE(time_zone, ''),
+			attributes,
+			preferred_channel,
+			created_at
+		FROM users
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY id
+		LIMIT $` + strconv.Itoa(len(args))
+
+	rows, err := r.Client.Pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s -> %w", operation, err)
 	}
 	defer rows.Close()
 
-	var users []entity.User
+	users := []entity.User{}
 
 	for rows.Next() {
 		var user entity.User
@@ -35,6 +75,11 @@ func (r *UsersRepository) List(ctx context.Context) ([]entity.User, error) {
 			&user.ID,
 			&user.Name,
 			&user.PhoneNumber,
+			&user.WaID,
+			&user.Consent,
+			&user.TimeZone,
+			&user.Attributes,
+			&user.PreferredChannel,
 			&user.CreatedAt,
 		); err != nil {
 			return []entity.User{}, fmt.Errorf("%s -> %w", operation, err)
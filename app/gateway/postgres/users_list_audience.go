@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// ListAudience returns up to limit opted-in users matching the audience with
+// id greater than afterID, in id order.
+func (r *UsersRepository) ListAudience(ctx context.Context, audience entity.CampaignAudience, afterID string, limit int) ([]entity.User, error) {
+	const operation = "Repository.UsersRepository.ListAudience"
+
+	conditions, args := audienceConditions(ctx, audience)
+
+	if afterID != "" {
+		args = append(args, afterID)
+		conditions = append(conditions, "id > $"+strconv.Itoa(len(args)))
+	}
+
+	args = append(args, limit)
+
+	query := `
+		SELECT
+			id,
+			name,
+			phone_number,
+			COALESCE(wa_id, ''),
+			consent,
+			COALESCE(time_zone, ''),
+			attributes,
+			preferred_channel,
+			created_at
+		FROM users
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY id
+		LIMIT $` + strconv.Itoa(len(args))
+
+	rows, err := r.Client.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s -> %w", operation, err)
+	}
+	defer rows.Close()
+
+	var users []entity.User
+
+	for rows.Next() {
+		var user entity.User
+
+		if err := rows.Scan(
+			&user.ID,
+			&user.Name,
+			&user.PhoneNumber,
+			&user.WaID,
+			&user.Consent,
+			&user.TimeZone,
+			&user.Attributes,
+			&user.PreferredChannel,
+			&user.CreatedAt,
+		); err != nil {
+			return []entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+func (r *UsersRepository) CountAudience(ctx context.Context, audience entity.CampaignAudience) (int, error) {
+	const operation = "Repository.UsersRepository.CountAudience"
+
+	conditions, args := audienceConditions(ctx, audience)
+
+	query := `SELECT count(*) FROM users WHERE ` + strings.Join(conditions, " AND ")
+
+	var count int
+
+	err := r.Client.Pool.QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return count, nil
+}
+
+func audienceConditions(ctx context.Context, audience entity.CampaignAudience) ([]string, []any) {
+	args := []any{tenantID(ctx)}
+	conditions := []string{"tenant_id = $1", "consent = 'opted_in'", "deleted_at IS NULL"}
+
+	if len(audience.PhonePrefixes) > 0 {
+		args = append(args, audience.PhonePrefixes)
+		conditions = append(conditions, "phone_number LIKE ANY (SELECT prefix || '%' FROM unnest($"+strconv.Itoa(len(args))+"::text[]) AS prefix)")
+	}
+
+	if len(audience.TimeZones) > 0 {
+		args = append(args, audience.TimeZones)
+		conditions = append(conditions, "time_zone = ANY ($"+strconv.Itoa(len(args))+"::text[])")
+	}
+
+	if audience.CreatedAfter != nil {
+		args = append(args, *audience.CreatedAfter)
+		conditions = append(conditions, "created_at >= $"+strconv.Itoa(len(args)))
+	}
+
+	if audience.CreatedBefore != nil {
+		args = append(args, *audience.CreatedBefore)
+		conditions = append(conditions, "created_at < $"+strconv.Itoa(len(args)))
+	}
+
+	return conditions, args
+}
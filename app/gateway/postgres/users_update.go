@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/chatbot-go/app/domain/entity"
+	"github.com/chatbot-go/app/domain/erring"
+)
+
+func (r *UsersRepository) Update(ctx context.Context, user entity.User) (entity.User, error) {
+	const (
+		operation = "Repository.UsersRepository.Update"
+		query     = `
+			UPDATE users SET
+				name = $2,
+				phone_number = $3,
+				time_zone = NULLIF($4, ''),
+				preferred_channel = $5,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+				AND tenant_id = $6
+				AND deleted_at IS NULL
+			RETURNING COALESCE(wa_id, ''), consent, attributes, created_at
+		`
+	)
+
+	err := r.Client.Pool.QueryRow(
+		ctx,
+		query,
+		user.ID,
+		user.Name,
+		user.PhoneNumber,
+		user.TimeZone,
+		user.PreferredChannel,
+		tenantID(ctx),
+	).Scan(
+		&user.WaID,
+		&user.Consent,
+		&user.Attributes,
+		&user.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.User{}, fmt.Errorf("%s -> %w", operation, erring.ErrUserNotFound)
+		}
+
+		if isUniqueViolation(err) {
+			return entity.User{}, fmt.Errorf("%s -> %w", operation, erring.ErrUserAlreadyExists)
+		}
+
+		return entity.User{}, fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return user, nil
+}
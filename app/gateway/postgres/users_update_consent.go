@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/entity"
+)
+
+// UpdateConsent changes the user consent and records the change in the audit
+// trail. Nothing is recorded when the consent is already the requested one.
+func (r *UsersRepository) UpdateConsent(ctx context.Context, event entity.ConsentEvent) error {
+	const (
+		operation = "Repository.UsersRepository.UpdateConsent"
+		query     = `
+			WITH updated AS (
+				UPDATE users SET
+					consent = $2,
+					consent_updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1
+					AND tenant_id = $5
+					AND consent <> $2
+				RETURNING id
+			)
+			INSERT INTO user_consent_events (user_id, consent, source, keyword)
+				SELECT id, $2, $3, NULLIF($4, '') FROM updated
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		event.UserID,
+		event.Consent,
+		event.Source,
+		event.Keyword,
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *UsersRepository) UpdatePreferredChannel(ctx context.Context, id, channel string) error {
+	const (
+		operation = "Repository.UsersRepository.UpdatePreferredChannel"
+		query     = `
+			UPDATE users SET
+				preferred_channel = $2,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+				AND tenant_id = $3
+		`
+	)
+
+	_, err := r.Client.Pool.Exec(
+		ctx,
+		query,
+		id,
+		channel,
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
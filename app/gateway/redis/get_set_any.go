@@ -46,3 +46,20 @@ func (c *Client) Set(ctx context.Context, key string, obj any, ttl time.Duration
 
 	return nil
 }
+
+// SetNX sets the key only if it does not exist yet, and tells whether it did.
+func (c *Client) SetNX(ctx context.Context, key string, obj any, ttl time.Duration) (bool, error) {
+	const operation = "Redis.SetNX"
+
+	bytes, err := json.Marshal(obj)
+	if err != nil {
+		return false, fmt.Errorf("%s (%s) -> %w", operation, key, err)
+	}
+
+	set, err := c.Client.SetNX(ctx, key, string(bytes), ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("%s (%s) -> %w", operation, key, err)
+	}
+
+	return set, nil
+}
@@ -0,0 +1,83 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/chatbot-go/app/domain/dto"
+)
+
+// gcraScript implements the generic cell rate algorithm: each key holds the
+// theoretical arrival time (TAT) of the next request in milliseconds, and a
+// request is allowed while it is no more than burst emission intervals ahead
+// of now. Every key is checked before any is updated, so a slot is taken
+// from all of them or from none. The server clock is used so every worker
+// shares the same time.
+//
+// ARGV holds the emission interval and burst of each key, in order. Returns
+// 0 when allowed, otherwise the milliseconds to wait.
+var gcraScript = goredis.NewScript(`
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local wait = 0
+local tats = {}
+
+for i, key in ipairs(KEYS) do
+	local emission = tonumber(ARGV[i * 2 - 1])
+	local burst = tonumber(ARGV[i * 2])
+
+	local tat = tonumber(redis.call('GET', key) or now)
+	if tat < now then
+		tat = now
+	end
+
+	local newTat = tat + emission
+	local allowAt = newTat - emission * burst
+
+	if allowAt > now then
+		wait = math.max(wait, math.ceil(allowAt - now))
+	end
+
+	tats[i] = newTat
+end
+
+if wait > 0 then
+	return wait
+end
+
+for i, key in ipairs(KEYS) do
+	redis.call('SET', key, tats[i], 'PX', math.ceil(tats[i] - now))
+end
+
+return 0
+`)
+
+// ReserveRates takes a slot from every limit at once. It returns zero when
+// the slots were taken, otherwise how long to wait before trying again,
+// without taking any.
+func (c *Client) ReserveRates(ctx context.Context, limits []dto.RateLimit) (time.Duration, error) {
+	const operation = "Redis.ReserveRates"
+
+	if len(limits) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, 0, len(limits))
+	args := make([]any, 0, len(limits)*2) //nolint:gomnd
+
+	for _, limit := range limits {
+		keys = append(keys, limit.Key)
+		args = append(args, float64(limit.Period.Milliseconds())/float64(limit.Count), limit.Count)
+	}
+
+	wait, err := gcraScript.Run(ctx, c.Client, keys, args...).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("%s (%v) -> %w", operation, keys, err)
+	}
+
+	return time.Duration(wait) * time.Millisecond, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"sync"
 	"time"
 
@@ -21,6 +22,16 @@ import (
 var (
 	ErrConsumerClosed = errors.New("sqs: consumer closed")
 
+	// Errors that will fail on every delivery, so the message is discarded
+	// instead of being retried until it expires.
+	nonRetryableErrors = []error{
+		erring.ErrUserNotFound,
+		erring.ErrUserMessageTooLong,
+		erring.ErrChannelNotSupported,
+		erring.ErrTemplateBodyMissing,
+		erring.ErrTenantNotFound,
+	}
+
 	consumerCtx    context.Context
 	consumerCancel context.CancelFunc
 )
@@ -39,6 +50,12 @@ func (c *Client) ListenAndConsume(ctx context.Context, useCase useCase) error {
 	group.Go(func() error {
 		return c.startConsumers(consumerCtx, queues.WebhooksTwilio, handler.WebhooksTwilio)
 	})
+	group.Go(func() error {
+		return c.startConsumers(consumerCtx, queues.WebhooksTwilioStatus, handler.WebhooksTwilioStatus)
+	})
+	group.Go(func() error {
+		return c.startConsumers(consumerCtx, queues.Outbound, handler.Outbound)
+	})
 	group.Go(func() error {
 		<-groupCtx.Done()
 
@@ -152,6 +169,10 @@ func (c *Client) handleMessage(ctx context.Context, queue Queue, msg sqstypes.Me
 
 	groupID := msg.Attributes[string(sqstypes.MessageSystemAttributeNameMessageGroupId)]
 
+	if receiveCount, err := strconv.Atoi(msg.Attributes[string(sqstypes.MessageSystemAttributeNameApproximateReceiveCount)]); err == nil {
+		ctx = context.WithValue(ctx, receiveCountKey{}, receiveCount)
+	}
+
 	err := queue.Handler(ctx, []byte(*msg.Body), groupID)
 	if err != nil {
 		sqsEventErr := new(erring.SQSEventError)
@@ -159,7 +180,16 @@ func (c *Client) handleMessage(ctx context.Context, queue Queue, msg sqstypes.Me
 			return c.changeMessageVisibility(ctx, queue, msg, sqsEventErr.NewVisibilityTimeout)
 		}
 
-		return fmt.Errorf("%s -> %w", operation, err)
+		if !isNonRetryable(err) {
+			return fmt.Errorf("%s -> %w", operation, err)
+		}
+
+		slog.WarnContext(
+			ctx,
+			fmt.Errorf("%s -> discarding message: %w", operation, err).Error(),
+			slog.String("sqs_queue_name", queue.Name),
+			slog.String("sqs_message_id", *msg.MessageId),
+		)
 	}
 
 	_, err = c.client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
@@ -187,3 +217,25 @@ func (c *Client) changeMessageVisibility(ctx context.Context, queue Queue, msg s
 
 	return nil
 }
+
+type receiveCountKey struct{}
+
+// receiveCountFromContext returns how many times the message being handled
+// was delivered, starting at 1.
+func receiveCountFromContext(ctx context.Context) int {
+	if receiveCount, ok := ctx.Value(receiveCountKey{}).(int); ok {
+		return receiveCount
+	}
+
+	return 1
+}
+
+func isNonRetryable(err error) bool {
+	for _, target := range nonRetryableErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,41 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	"github.com/chatbot-go/app/domain/dto"
+)
+
+func (e *Enqueuer) Outbound(ctx context.Context, message dto.OutboundMessage) error {
+	const operation = "SQS.Enqueuer.Outbound"
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	deduplicationID := message.Key
+	if message.CampaignID != "" {
+		deduplicationID = message.CampaignID + ":" + message.UserID
+	}
+
+	_, err = e.client.client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:    queues.Outbound.URL,
+		MessageBody: aws.String(string(body)),
+
+		// required for FIFO queues; grouping by user spreads a campaign across
+		// the workers and deduplication keeps a resumed run from sending twice
+		MessageGroupId:         aws.String(message.UserID),
+		MessageDeduplicationId: aws.String(deduplicationID),
+	})
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
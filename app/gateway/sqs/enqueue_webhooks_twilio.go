@@ -11,7 +11,7 @@ import (
 	"github.com/chatbot-go/app/domain/dto"
 )
 
-func (e *Enqueuer) WebhooksTwilio(ctx context.Context, webhook dto.WebhookTwilio) error {
+func (e *Enqueuer) WebhooksTwilio(ctx context.Context, webhook dto.InboundMessage) error {
 	const operation = "SQS.Enqueuer.WebhooksTwilio"
 
 	body, err := json.Marshal(webhook)
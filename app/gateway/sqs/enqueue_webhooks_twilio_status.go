@@ -0,0 +1,35 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	"github.com/chatbot-go/app/domain/dto"
+)
+
+func (e *Enqueuer) WebhooksTwilioStatus(ctx context.Context, webhook dto.MessageStatusUpdate) error {
+	const operation = "SQS.Enqueuer.WebhooksTwilioStatus"
+
+	body, err := json.Marshal(webhook)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	_, err = e.client.client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:    queues.WebhooksTwilioStatus.URL,
+		MessageBody: aws.String(string(body)),
+
+		// required for FIFO queues; grouping by message keeps its statuses in order
+		MessageGroupId:         aws.String(webhook.MessageSid),
+		MessageDeduplicationId: aws.String(webhook.MessageSid + ":" + webhook.Status),
+	})
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/usecase"
+)
+
+func (h *Handler) Outbound(ctx context.Context, data []byte, _ string) error {
+	const operation = "SQS.Handler.Outbound"
+
+	var input usecase.SendOutboundMessageInput
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	input.Attempt = receiveCountFromContext(ctx)
+
+	err := h.useCase.SendOutboundMessage(withTenant(ctx, input.TenantID), input)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -16,7 +16,7 @@ func (h *Handler) WebhooksTwilio(ctx context.Context, data []byte, _ string) err
 		return fmt.Errorf("%s -> %w", operation, err)
 	}
 
-	err := h.useCase.ProcessTwilioWebhook(ctx, input)
+	err := h.useCase.ProcessTwilioWebhook(withTenant(ctx, input.TenantID), input)
 	if err != nil {
 		return fmt.Errorf("%s -> %w", operation, err)
 	}
@@ -0,0 +1,25 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/chatbot-go/app/domain/usecase"
+)
+
+func (h *Handler) WebhooksTwilioStatus(ctx context.Context, data []byte, _ string) error {
+	const operation = "SQS.Handler.WebhooksTwilioStatus"
+
+	var input usecase.ProcessTwilioStatusWebhookInput
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	err := h.useCase.ProcessTwilioStatusWebhook(withTenant(ctx, input.TenantID), input)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/chatbot-go/app/domain/usecase"
+	"github.com/chatbot-go/app/library/ctxkey"
 )
 
 type Handler struct {
@@ -16,8 +17,20 @@ func NewHandler(uc useCase) *Handler {
 	}
 }
 
+// withTenant scopes the handling of a message to its tenant. Messages queued
+// before tenants existed carry none and fall in the default one.
+func withTenant(ctx context.Context, tenantID string) context.Context {
+	if tenantID == "" {
+		return ctx
+	}
+
+	return ctxkey.PutTenantID(ctx, tenantID)
+}
+
 //go:generate moq -rm -out handler_mocks.gen.go . useCase
 
 type useCase interface {
 	ProcessTwilioWebhook(ctx context.Context, input usecase.ProcessTwilioWebhookInput) error
+	ProcessTwilioStatusWebhook(ctx context.Context, input usecase.ProcessTwilioStatusWebhookInput) error
+	SendOutboundMessage(ctx context.Context, input usecase.SendOutboundMessageInput) error
 }
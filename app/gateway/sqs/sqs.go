@@ -22,7 +22,9 @@ const (
 var queues = &Queues{}
 
 type Queues struct {
-	WebhooksTwilio Queue
+	WebhooksTwilio       Queue
+	WebhooksTwilioStatus Queue
+	Outbound             Queue
 }
 
 type Queue struct {
@@ -80,6 +82,8 @@ func (c *Client) setupQueues(ctx context.Context, development bool) error {
 	if development {
 		for _, queue := range []string{
 			c.cfg.WebhooksTwilioQueue,
+			c.cfg.WebhooksTwilioStatusQueue,
+			c.cfg.OutboundQueue,
 		} {
 			_, err = c.client.CreateQueue(ctx, &awssqs.CreateQueueInput{
 				QueueName: aws.String(queue),
@@ -100,6 +104,20 @@ func (c *Client) setupQueues(ctx context.Context, development bool) error {
 		return fmt.Errorf("%s -> %w", operation, err)
 	}
 
+	queues.WebhooksTwilioStatus = Queue{Name: c.cfg.WebhooksTwilioStatusQueue}
+
+	queues.WebhooksTwilioStatus.URL, err = c.getQueueURL(ctx, queues.WebhooksTwilioStatus.Name)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
+	queues.Outbound = Queue{Name: c.cfg.OutboundQueue}
+
+	queues.Outbound.URL, err = c.getQueueURL(ctx, queues.Outbound.Name)
+	if err != nil {
+		return fmt.Errorf("%s -> %w", operation, err)
+	}
+
 	return nil
 }
 
@@ -10,6 +10,8 @@ const (
 	keyAuthorizationHeader ctxKey = iota
 	keyIdempotencyKey
 	keyRequestID
+	keyAgentID
+	keyTenantID
 )
 
 func GetAuthorizationHeader(ctx context.Context) (string, bool) {
@@ -47,3 +49,27 @@ func GetRequestID(ctx context.Context) (string, bool) {
 func PutRequestID(ctx context.Context, requestID string) context.Context {
 	return context.WithValue(ctx, keyRequestID, requestID)
 }
+
+func GetAgentID(ctx context.Context) (string, bool) {
+	if s, ok := ctx.Value(keyAgentID).(string); ok {
+		return s, true
+	}
+
+	return "", false
+}
+
+func PutAgentID(ctx context.Context, agentID string) context.Context {
+	return context.WithValue(ctx, keyAgentID, agentID)
+}
+
+func GetTenantID(ctx context.Context) (string, bool) {
+	if s, ok := ctx.Value(keyTenantID).(string); ok {
+		return s, true
+	}
+
+	return "", false
+}
+
+func PutTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, keyTenantID, tenantID)
+}
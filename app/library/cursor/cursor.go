@@ -0,0 +1,33 @@
+// Package cursor encodes keyset pagination positions as opaque strings, so
+// clients pass them back without depending on their content.
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+var ErrInvalid = errors.New("cursor: invalid")
+
+const separator = "\x1f"
+
+// Encode joins the values of the last item of a page into a cursor.
+func Encode(values ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(values, separator)))
+}
+
+// Decode splits a cursor into the count values it was encoded with.
+func Decode(cursor string, count int) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+
+	values := strings.Split(string(data), separator)
+	if len(values) != count {
+		return nil, ErrInvalid
+	}
+
+	return values, nil
+}
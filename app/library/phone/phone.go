@@ -0,0 +1,65 @@
+// Package phone parses the phone numbers received from the channels and
+// normalises them to E.164, the format users are stored with.
+package phone
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/chatbot-go/app/library/util"
+)
+
+var ErrInvalid = errors.New("phone: invalid number")
+
+const (
+	// E.164 numbers have at most 15 digits, with the country code.
+	minDigits = 8
+	maxDigits = 15
+
+	brazilCallingCode = "55"
+	// Area code plus the 8 digits of the subscriber number.
+	brazilLegacyMobileLen = 10
+)
+
+// ParseAddress splits a channel address such as whatsapp:+5511912345678
+// into its channel and normalised number. The channel is empty when the
+// address has no prefix, as in SMS.
+func ParseAddress(address string) (string, string, error) {
+	channel, number, found := strings.Cut(address, ":")
+	if !found {
+		channel, number = "", address
+	}
+
+	normalized, err := Normalize(number)
+	if err != nil {
+		return "", "", err
+	}
+
+	return strings.ToLower(strings.TrimSpace(channel)), normalized, nil
+}
+
+// Normalize returns the E.164 form of an international phone number: the
+// formatting characters are removed and the digits, country code included,
+// are prefixed with +. Brazilian mobile
+// numbers without the ninth digit, as WhatsApp still reports older ones,
+// get it back so both forms resolve to the same user.
+func Normalize(number string) (string, error) {
+	digits := util.KeepNumbers(number)
+
+	if len(digits) < minDigits || len(digits) > maxDigits || digits[0] == '0' {
+		return "", ErrInvalid
+	}
+
+	if national, ok := strings.CutPrefix(digits, brazilCallingCode); ok && isBrazilLegacyMobile(national) {
+		digits = brazilCallingCode + national[:2] + "9" + national[2:]
+	}
+
+	return "+" + digits, nil
+}
+
+// isBrazilLegacyMobile tells whether a Brazilian national number is a mobile
+// one missing the ninth digit. Mobile subscriber numbers start with 6 to 9,
+// landlines keep 8 digits and start with 2 to 5.
+func isBrazilLegacyMobile(national string) bool {
+	return len(national) == brazilLegacyMobileLen && national[2] >= '6'
+}
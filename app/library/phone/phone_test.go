@@ -0,0 +1,85 @@
+package phone_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chatbot-go/app/library/phone"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		number string
+		want   string
+		err    error
+	}{
+		{name: "e164 mobile", number: "+5511912345678", want: "+5511912345678"},
+		{name: "formatted mobile", number: "+55 (11) 91234-5678", want: "+5511912345678"},
+		{name: "mobile without plus", number: "5511912345678", want: "+5511912345678"},
+		{name: "legacy mobile starting with 9", number: "+551191234567", want: "+5511991234567"},
+		{name: "legacy mobile starting with 8", number: "+552181234567", want: "+5521981234567"},
+		{name: "legacy mobile starting with 6", number: "+556161234567", want: "+5561961234567"},
+		{name: "landline starting with 2", number: "+551121234567", want: "+551121234567"},
+		{name: "landline starting with 5", number: "+551151234567", want: "+551151234567"},
+		{name: "other country", number: "+14155552671", want: "+14155552671"},
+		{name: "other country with 10 national digits", number: "+5491191234567", want: "+5491191234567"},
+		{name: "too short", number: "+5511", err: phone.ErrInvalid},
+		{name: "too long", number: "+5511912345678901", err: phone.ErrInvalid},
+		{name: "leading zero", number: "011912345678", err: phone.ErrInvalid},
+		{name: "empty", number: "", err: phone.ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := phone.Normalize(tt.number)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Normalize(%q) error = %v, want %v", tt.number, err, tt.err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		address     string
+		wantChannel string
+		wantNumber  string
+		err         error
+	}{
+		{name: "whatsapp", address: "whatsapp:+5511912345678", wantChannel: "whatsapp", wantNumber: "+5511912345678"},
+		{name: "whatsapp legacy mobile", address: "WhatsApp:+551191234567", wantChannel: "whatsapp", wantNumber: "+5511991234567"},
+		{name: "sms without prefix", address: "+5511912345678", wantNumber: "+5511912345678"},
+		{name: "invalid number", address: "whatsapp:+55", err: phone.ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			channel, number, err := phone.ParseAddress(tt.address)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseAddress(%q) error = %v, want %v", tt.address, err, tt.err)
+			}
+
+			if channel != tt.wantChannel || number != tt.wantNumber {
+				t.Errorf("ParseAddress(%q) = %q, %q, want %q, %q", tt.address, channel, number, tt.wantChannel, tt.wantNumber)
+			}
+		})
+	}
+}
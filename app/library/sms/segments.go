@@ -0,0 +1,70 @@
+// Package sms computes how a text is encoded and split into SMS segments,
+// which is what carriers bill.
+package sms
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+type Encoding string
+
+const (
+	GSM7 Encoding = "GSM-7"
+	UCS2 Encoding = "UCS-2"
+)
+
+const (
+	gsm7SingleLimit    = 160
+	gsm7SegmentLimit   = 153
+	ucs2SingleLimit    = 70
+	ucs2SegmentLimit   = 67
+	gsm7ExtensionWidth = 2
+)
+
+// gsm7Basic is the GSM 03.38 default alphabet, each character taking one
+// septet.
+const gsm7Basic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsm7Extension characters are sent with an escape, taking two septets.
+const gsm7Extension = "^{}\\[~]|€\f"
+
+// Encode returns the encoding of the text and its length in that encoding:
+// septets for GSM-7, UTF-16 code units for UCS-2.
+func Encode(text string) (Encoding, int) {
+	septets := 0
+
+	for _, r := range text {
+		switch {
+		case strings.ContainsRune(gsm7Basic, r):
+			septets++
+		case strings.ContainsRune(gsm7Extension, r):
+			septets += gsm7ExtensionWidth
+		default:
+			return UCS2, len(utf16.Encode([]rune(text)))
+		}
+	}
+
+	return GSM7, septets
+}
+
+// Segments returns the number of segments the text is sent in. Multipart
+// messages lose room in every segment to the concatenation header.
+func Segments(text string) int {
+	encoding, length := Encode(text)
+
+	single, segment := gsm7SingleLimit, gsm7SegmentLimit
+	if encoding == UCS2 {
+		single, segment = ucs2SingleLimit, ucs2SegmentLimit
+	}
+
+	switch {
+	case length == 0:
+		return 0
+	case length <= single:
+		return 1
+	default:
+		return (length + segment - 1) / segment
+	}
+}
@@ -0,0 +1,76 @@
+package sms_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chatbot-go/app/library/sms"
+)
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		text         string
+		wantEncoding sms.Encoding
+		wantLength   int
+	}{
+		{name: "empty", text: "", wantEncoding: sms.GSM7, wantLength: 0},
+		{name: "basic alphabet only", text: "Hello @£$¥ Ñ§¿", wantEncoding: sms.GSM7, wantLength: 14},
+		{name: "escape characters", text: "^{}[]~|€\\", wantEncoding: sms.GSM7, wantLength: 18},
+		{name: "escape mixed with basic", text: "a{b}", wantEncoding: sms.GSM7, wantLength: 6},
+		{name: "accent outside the alphabet", text: "olá", wantEncoding: sms.UCS2, wantLength: 3},
+		{name: "emoji as surrogate pair", text: "hi 👋", wantEncoding: sms.UCS2, wantLength: 5},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoding, length := sms.Encode(tt.text)
+			if encoding != tt.wantEncoding || length != tt.wantLength {
+				t.Errorf("Encode(%q) = %s, %d, want %s, %d", tt.text, encoding, length, tt.wantEncoding, tt.wantLength)
+			}
+		})
+	}
+}
+
+func TestSegments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{name: "empty", text: "", want: 0},
+		{name: "gsm7 single part limit", text: strings.Repeat("a", 160), want: 1},
+		{name: "gsm7 over single part limit", text: strings.Repeat("a", 161), want: 2},
+		{name: "gsm7 two parts limit", text: strings.Repeat("a", 306), want: 2},
+		{name: "gsm7 over two parts limit", text: strings.Repeat("a", 307), want: 3},
+		{name: "gsm7 escapes at single part limit", text: strings.Repeat("€", 80), want: 1},
+		{name: "gsm7 escapes over single part limit", text: strings.Repeat("a", 159) + "^", want: 2},
+		{name: "ucs2 single part limit", text: strings.Repeat("á", 70), want: 1},
+		{name: "ucs2 over single part limit", text: strings.Repeat("á", 71), want: 2},
+		{name: "ucs2 two parts limit", text: strings.Repeat("á", 134), want: 2},
+		{name: "ucs2 over two parts limit", text: strings.Repeat("á", 135), want: 3},
+		{name: "one character switches to ucs2", text: strings.Repeat("a", 70) + "á", want: 2},
+		{name: "emoji counts two code units", text: strings.Repeat("a", 68) + "👋", want: 1},
+		{name: "emoji over ucs2 single part limit", text: strings.Repeat("a", 69) + "👋", want: 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := sms.Segments(tt.text); got != tt.want {
+				t.Errorf("Segments(%q) = %d, want %d", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package timezone
+
+import (
+	"github.com/chatbot-go/app/library/util"
+)
+
+// byCallingCode maps country calling codes to the IANA time zone of the
+// country capital, used when the user did not set one.
+var byCallingCode = map[string]string{
+	"1":   "America/New_York",
+	"33":  "Europe/Paris",
+	"34":  "Europe/Madrid",
+	"39":  "Europe/Rome",
+	"44":  "Europe/London",
+	"49":  "Europe/Berlin",
+	"51":  "America/Lima",
+	"52":  "America/Mexico_City",
+	"54":  "America/Argentina/Buenos_Aires",
+	"55":  "America/Sao_Paulo",
+	"56":  "America/Santiago",
+	"57":  "America/Bogota",
+	"58":  "America/Caracas",
+	"351": "Europe/Lisbon",
+	"591": "America/La_Paz",
+	"593": "America/Guayaquil",
+	"595": "America/Asuncion",
+	"598": "America/Montevideo",
+}
+
+// FromPhoneNumber returns the time zone of the country of an international
+// phone number.
+func FromPhoneNumber(phoneNumber string) (string, bool) {
+	const maxCallingCodeLen = 3
+
+	digits := util.KeepNumbers(phoneNumber)
+
+	for size := maxCallingCodeLen; size > 0; size-- {
+		if len(digits) <= size {
+			continue
+		}
+
+		if zone, ok := byCallingCode[digits[:size]]; ok {
+			return zone, true
+		}
+	}
+
+	return "", false
+}
@@ -2,8 +2,8 @@ package telemetry
 
 import (
 	"context"
+	"io"
 	"log/slog"
-	"os"
 	"strconv"
 
 	"go.opentelemetry.io/otel/trace"
@@ -11,19 +11,21 @@ import (
 	"github.com/chatbot-go/app/library/ctxkey"
 )
 
-func SetLogger(development bool, attrs ...slog.Attr) {
+// SetLogger makes the default logger, and the log package through it, write
+// to output.
+func SetLogger(output io.Writer, development bool, attrs ...slog.Attr) {
 	var handler *slogHandler
 
 	if development {
 		handler = &slogHandler{
-			Handler: slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+			Handler: slog.NewTextHandler(output, &slog.HandlerOptions{
 				AddSource: true,
 				Level:     slog.LevelDebug,
 			}),
 		}
 	} else {
 		handler = &slogHandler{
-			Handler: slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+			Handler: slog.NewJSONHandler(output, &slog.HandlerOptions{
 				AddSource: true,
 				Level:     slog.LevelInfo,
 			}),
@@ -70,6 +72,10 @@ func (h *slogHandler) Handle(ctx context.Context, record slog.Record) error {
 		attrs = append(attrs, slog.String("idempotency_key", idempotencyKey))
 	}
 
+	if tenantID, ok := ctxkey.GetTenantID(ctx); ok {
+		attrs = append(attrs, slog.String("tenant_id", tenantID))
+	}
+
 	return h.Handler.WithAttrs(attrs).Handle(ctx, record) //nolint:wrapcheck
 }
 
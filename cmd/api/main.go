@@ -17,6 +17,7 @@ import (
 	"github.com/chatbot-go/app"
 	"github.com/chatbot-go/app/config"
 	"github.com/chatbot-go/app/gateway/api"
+	"github.com/chatbot-go/app/gateway/blob"
 	"github.com/chatbot-go/app/gateway/postgres"
 	"github.com/chatbot-go/app/gateway/redis"
 	"github.com/chatbot-go/app/gateway/sqs"
@@ -40,7 +41,7 @@ func main() {
 	}
 
 	// Logger
-	telemetry.SetLogger(cfg.Development,
+	telemetry.SetLogger(os.Stdout, cfg.Development,
 		slog.String("build_time", BuildTime),
 		slog.String("build_commit", BuildCommit),
 		slog.String("build_tag", BuildTag),
@@ -72,8 +73,14 @@ func main() {
 		log.Fatalf("failed to start sqs: %v", err)
 	}
 
+	// Blob Storage
+	blobStore, err := blob.New(ctx, cfg.Blob)
+	if err != nil {
+		log.Fatalf("failed to start blob storage: %v", err)
+	}
+
 	// Application
-	appl, err := app.New(cfg, postgresClient, redisClient, sqsEnqueuer)
+	appl, err := app.New(ctx, cfg, postgresClient, redisClient, sqsEnqueuer, blobStore)
 	if err != nil {
 		log.Fatalf("failed to start application: %v", err)
 	}
@@ -82,7 +89,7 @@ func main() {
 	server := &http.Server{
 		Addr:         cfg.Server.Address,
 		BaseContext:  func(_ net.Listener) context.Context { return ctx },
-		Handler:      api.New(cfg, redisClient, appl.UseCase, appl.TwilioClient).Handler,
+		Handler:      api.New(cfg, redisClient, appl.UseCase, appl.TwilioTenants, appl.WhatsappCloudClient).Handler,
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 	}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/chatbot-go/app"
 	"github.com/chatbot-go/app/config"
+	"github.com/chatbot-go/app/gateway/blob"
 	"github.com/chatbot-go/app/gateway/cronjob"
 	"github.com/chatbot-go/app/gateway/postgres"
 	"github.com/chatbot-go/app/gateway/redis"
@@ -37,8 +38,9 @@ func main() {
 		log.Fatalf("failed to load configurations: %v", err)
 	}
 
-	// Logger
-	telemetry.SetLogger(cfg.Development,
+	// Logger, on stderr since commands such as export-conversations write
+	// their output to stdout.
+	telemetry.SetLogger(os.Stderr, cfg.Development,
 		slog.String("build_time", BuildTime),
 		slog.String("build_commit", BuildCommit),
 		slog.String("build_tag", BuildTag),
@@ -70,8 +72,14 @@ func main() {
 		log.Fatalf("failed to start sqs: %v", err)
 	}
 
+	// Blob Storage
+	blobStore, err := blob.New(ctx, cfg.Blob)
+	if err != nil {
+		log.Fatalf("failed to start blob storage: %v", err)
+	}
+
 	// Application
-	appl, err := app.New(cfg, postgresClient, redisClient, sqsEnqueuer)
+	appl, err := app.New(ctx, cfg, postgresClient, redisClient, sqsEnqueuer, blobStore)
 	if err != nil {
 		log.Fatalf("failed to start application: %v", err)
 	}